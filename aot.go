@@ -0,0 +1,480 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"go/constant"
+	"go/format"
+	"go/token"
+	"go/types"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/ssa"
+)
+
+// ssaPackage return the interpreted package of path.
+func (i *Interp) ssaPackage(path string) *ssa.Package {
+	if i.mainpkg.Pkg.Path() == path {
+		return i.mainpkg
+	}
+	return i.mainpkg.Prog.ImportedPackage(path)
+}
+
+// aotCompiler emit the Go source of package functions. The functions only
+// use values of basic types, the arithmetic, conversion, branch, string
+// index and slice, and call the functions emitted, so the native functions
+// have the same type of interpreted functions.
+type aotCompiler struct {
+	funcs map[*ssa.Function]string // function -> name in plugin
+	names map[ssa.Value]string     // value -> name in function
+	buf   bytes.Buffer
+}
+
+// TranspilePackage return the Go source of plugin of the functions of
+// package can run native, and the names of these functions. The function
+// FN of plugin is the native function of funcs[N].
+func (i *Interp) TranspilePackage(path string) (src []byte, funcs []string, err error) {
+	pkg := i.ssaPackage(path)
+	if pkg == nil {
+		return nil, nil, fmt.Errorf("not found package %v", path)
+	}
+	set := make(map[*ssa.Function]bool)
+	for _, m := range pkg.Members {
+		if fn, ok := m.(*ssa.Function); ok && fn.Blocks != nil && fn.Synthetic == "" && fn.Name() != "init" {
+			set[fn] = true
+		}
+	}
+	// remove functions not supported until the callees are all supported
+	for changed := true; changed; {
+		changed = false
+		for fn := range set {
+			if !aotSupported(fn, set) {
+				delete(set, fn)
+				changed = true
+			}
+		}
+	}
+	var fns []*ssa.Function
+	for fn := range set {
+		fns = append(fns, fn)
+	}
+	sort.Slice(fns, func(i, j int) bool {
+		return fns[i].String() < fns[j].String()
+	})
+	c := &aotCompiler{funcs: make(map[*ssa.Function]string)}
+	for n, fn := range fns {
+		c.funcs[fn] = "F" + strconv.Itoa(n)
+		funcs = append(funcs, fn.String())
+	}
+	c.buf.WriteString("// Code generated by igop. DO NOT EDIT.\n\npackage main\n")
+	for _, fn := range fns {
+		c.function(fn)
+	}
+	if src, err = format.Source(c.buf.Bytes()); err != nil {
+		return nil, nil, err
+	}
+	return src, funcs, nil
+}
+
+// openPlugin open the plugin file and return the lookup of symbols, it is
+// nil if build without tag igop_plugin.
+var openPlugin func(file string) (func(sym string) (interface{}, error), error)
+
+// BuildPackagePlugin transpile the package and build the plugin in dir by
+// go command of the toolchain built the program. It return the plugin file
+// and the names of functions in it.
+func (i *Interp) BuildPackagePlugin(path string, dir string) (file string, funcs []string, err error) {
+	src, funcs, err := i.TranspilePackage(path)
+	if err != nil || len(funcs) == 0 {
+		return "", nil, err
+	}
+	gocmd, err := goCommand()
+	if err != nil {
+		return "", nil, err
+	}
+	// plugin path must be unique for each source
+	name := fmt.Sprintf("igopaot%x", sha1.Sum(src))
+	dir = filepath.Join(dir, name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", nil, err
+	}
+	mod := "module " + name + "\n\ngo 1.16\n"
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(mod), 0644); err != nil {
+		return "", nil, err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), src, 0644); err != nil {
+		return "", nil, err
+	}
+	var stderr bytes.Buffer
+	cmd := exec.Command(gocmd, "build", "-buildmode=plugin", "-o", name+".so", ".")
+	cmd.Env = append(os.Environ(), "GOWORK=off", "GOTOOLCHAIN=local", "CGO_ENABLED=1")
+	cmd.Stderr = &stderr
+	cmd.Dir = dir
+	if err := cmd.Run(); err != nil {
+		if stderr.Len() > 0 {
+			err = errors.New(stderr.String())
+		}
+		return "", nil, err
+	}
+	return filepath.Join(dir, name+".so"), funcs, nil
+}
+
+// goCommand return the go command of GOROOT, the plugin must be built by the
+// same version of program.
+func goCommand() (string, error) {
+	gocmd := filepath.Join(runtime.GOROOT(), "bin", "go")
+	if _, err := os.Stat(gocmd); err != nil {
+		gocmd = "go"
+	}
+	cmd := exec.Command(gocmd, "env", "GOVERSION")
+	cmd.Env = append(os.Environ(), "GOTOOLCHAIN=local")
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	if v := strings.TrimSpace(string(out)); v != runtime.Version() {
+		return "", fmt.Errorf("go command %v is %v, want %v", gocmd, v, runtime.Version())
+	}
+	return gocmd, nil
+}
+
+// PromotePackage build the plugin of package in dir and patch the
+// interpreted functions by the native functions of plugin. It return the
+// names of functions promoted, need build with tag igop_plugin. Only the
+// functions with params and results of bool, number or string types and
+// calling the functions promoted are promoted, the others are interpreted.
+func (i *Interp) PromotePackage(path string, dir string) ([]string, error) {
+	if openPlugin == nil {
+		return nil, ErrNoPlugin
+	}
+	file, funcs, err := i.BuildPackagePlugin(path, dir)
+	if err != nil || len(funcs) == 0 {
+		return nil, err
+	}
+	lookup, err := openPlugin(file)
+	if err != nil {
+		return nil, err
+	}
+	for n, fn := range funcs {
+		sym, err := lookup("F" + strconv.Itoa(n))
+		if err != nil {
+			return nil, err
+		}
+		if _, err := i.Patch(fn, sym); err != nil {
+			return nil, err
+		}
+	}
+	return funcs, nil
+}
+
+// aotBasic check the type is basic type of bool, number or string.
+func aotBasic(t types.Type) bool {
+	b, ok := t.(*types.Basic)
+	return ok && b.Info()&(types.IsBoolean|types.IsInteger|types.IsFloat|types.IsString) != 0 &&
+		b.Info()&types.IsUntyped == 0
+}
+
+// aotSupported check the function can emit by the supported functions.
+func aotSupported(fn *ssa.Function, set map[*ssa.Function]bool) bool {
+	sig := fn.Signature
+	if sig.Recv() != nil || sig.Variadic() {
+		return false
+	}
+	for _, tuple := range []*types.Tuple{sig.Params(), sig.Results()} {
+		for i := 0; i < tuple.Len(); i++ {
+			if !aotBasic(tuple.At(i).Type()) {
+				return false
+			}
+		}
+	}
+	for _, b := range fn.Blocks {
+		for _, instr := range b.Instrs {
+			switch instr := instr.(type) {
+			case *ssa.DebugRef:
+				continue
+			case *ssa.BinOp, *ssa.Convert, *ssa.Phi, *ssa.Index, *ssa.Extract,
+				*ssa.If, *ssa.Jump, *ssa.Return:
+			case *ssa.Lookup:
+				if instr.CommaOk {
+					return false
+				}
+			case *ssa.Slice:
+				if instr.Max != nil {
+					return false
+				}
+			case *ssa.UnOp:
+				if instr.Op == token.MUL || instr.Op == token.ARROW {
+					return false
+				}
+			case *ssa.Call:
+				switch f := instr.Call.Value.(type) {
+				case *ssa.Builtin:
+					if f.Name() != "len" {
+						return false
+					}
+				case *ssa.Function:
+					if !set[f] {
+						return false
+					}
+				default:
+					return false
+				}
+			default:
+				return false
+			}
+			if v, ok := instr.(ssa.Value); ok {
+				if t, ok := v.Type().(*types.Tuple); ok {
+					for i := 0; i < t.Len(); i++ {
+						if !aotBasic(t.At(i).Type()) {
+							return false
+						}
+					}
+				} else if !aotBasic(v.Type()) {
+					return false
+				}
+			}
+			for _, op := range instr.Operands(nil) {
+				switch v := (*op).(type) {
+				case nil, *ssa.Function, *ssa.Builtin:
+				case *ssa.Global, *ssa.FreeVar:
+					return false
+				case *ssa.Extract:
+					// type of tuple checked by call
+				default:
+					if _, ok := v.Type().(*types.Tuple); !ok && !aotBasic(v.Type()) {
+						return false
+					}
+				}
+			}
+		}
+	}
+	return true
+}
+
+func (c *aotCompiler) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&c.buf, format, args...)
+}
+
+// name return the Go expression of value.
+func (c *aotCompiler) name(v ssa.Value) string {
+	if k, ok := v.(*ssa.Const); ok {
+		t := k.Type().Underlying().(*types.Basic)
+		switch {
+		case t.Info()&types.IsBoolean != 0:
+			return strconv.FormatBool(constant.BoolVal(k.Value))
+		case t.Info()&types.IsString != 0:
+			return strconv.Quote(constant.StringVal(k.Value))
+		case t.Info()&types.IsFloat != 0:
+			f, _ := constant.Float64Val(k.Value)
+			return t.Name() + "(" + strconv.FormatFloat(f, 'g', -1, 64) + ")"
+		default:
+			return t.Name() + "(" + k.Value.ExactString() + ")"
+		}
+	}
+	return c.names[v]
+}
+
+func (c *aotCompiler) function(fn *ssa.Function) {
+	c.names = make(map[ssa.Value]string)
+	var params []string
+	for n, p := range fn.Params {
+		c.names[p] = "p" + strconv.Itoa(n)
+		params = append(params, c.names[p]+" "+p.Type().String())
+	}
+	c.printf("\nfunc %v(", c.funcs[fn])
+	for n, p := range params {
+		if n > 0 {
+			c.printf(", ")
+		}
+		c.printf("%v", p)
+	}
+	c.printf(")")
+	if res := fn.Signature.Results(); res.Len() > 0 {
+		c.printf(" (")
+		for n := 0; n < res.Len(); n++ {
+			if n > 0 {
+				c.printf(", ")
+			}
+			c.printf("%v", res.At(n).Type())
+		}
+		c.printf(")")
+	}
+	c.printf(" {\n")
+	// declare values before labels for goto
+	var id int
+	for _, b := range fn.Blocks {
+		for _, instr := range b.Instrs {
+			v, ok := instr.(ssa.Value)
+			if !ok {
+				continue
+			}
+			switch t := v.Type().(type) {
+			case *types.Tuple:
+				for n := 0; n < t.Len(); n++ {
+					c.printf("\tvar v%v_%v %v\n\t_ = v%v_%v\n", id, n, t.At(n).Type(), id, n)
+				}
+			default:
+				if _, ok := v.(*ssa.Extract); !ok {
+					c.printf("\tvar v%v %v\n\t_ = v%v\n", id, t, id)
+					c.names[v] = "v" + strconv.Itoa(id)
+				}
+			}
+			id++
+		}
+	}
+	for _, b := range fn.Blocks {
+		for _, instr := range b.Instrs {
+			if e, ok := instr.(*ssa.Extract); ok {
+				c.names[e] = fmt.Sprintf("v%v_%v", c.tupleID(fn, e.Tuple), e.Index)
+			}
+		}
+	}
+	for _, b := range fn.Blocks {
+		if len(b.Preds) > 0 {
+			c.printf("b%v:\n", b.Index)
+		}
+		for _, instr := range b.Instrs {
+			c.instr(instr)
+		}
+	}
+	c.printf("}\n")
+}
+
+// tupleID return the id of value declared for tuple.
+func (c *aotCompiler) tupleID(fn *ssa.Function, tuple ssa.Value) int {
+	var id int
+	for _, b := range fn.Blocks {
+		for _, instr := range b.Instrs {
+			if v, ok := instr.(ssa.Value); ok {
+				if v == tuple {
+					return id
+				}
+				id++
+			}
+		}
+	}
+	panic(fmt.Errorf("not found tuple %v", tuple))
+}
+
+func (c *aotCompiler) instr(instr ssa.Instruction) {
+	switch instr := instr.(type) {
+	case *ssa.BinOp:
+		c.printf("\t%v = %v %v %v\n", c.name(instr), c.name(instr.X), instr.Op, c.name(instr.Y))
+	case *ssa.UnOp:
+		c.printf("\t%v = %v%v\n", c.name(instr), instr.Op, c.name(instr.X))
+	case *ssa.Convert:
+		c.printf("\t%v = %v(%v)\n", c.name(instr), instr.Type(), c.name(instr.X))
+	case *ssa.Index:
+		c.printf("\t%v = %v[%v]\n", c.name(instr), c.name(instr.X), c.name(instr.Index))
+	case *ssa.Lookup:
+		c.printf("\t%v = %v[%v]\n", c.name(instr), c.name(instr.X), c.name(instr.Index))
+	case *ssa.Slice:
+		var lo, hi string
+		if instr.Low != nil {
+			lo = c.name(instr.Low)
+		}
+		if instr.High != nil {
+			hi = c.name(instr.High)
+		}
+		c.printf("\t%v = %v[%v:%v]\n", c.name(instr), c.name(instr.X), lo, hi)
+	case *ssa.Call:
+		var args []string
+		for _, arg := range instr.Call.Args {
+			args = append(args, c.name(arg))
+		}
+		var fn string
+		switch f := instr.Call.Value.(type) {
+		case *ssa.Builtin:
+			fn = f.Name()
+		case *ssa.Function:
+			fn = c.funcs[f]
+		}
+		call := fmt.Sprintf("%v(%v)", fn, joinNames(args))
+		switch t := instr.Type().(type) {
+		case *types.Tuple:
+			if t.Len() == 0 {
+				c.printf("\t%v\n", call)
+				return
+			}
+			id := c.tupleID(instr.Parent(), instr)
+			var res []string
+			for n := 0; n < t.Len(); n++ {
+				res = append(res, fmt.Sprintf("v%v_%v", id, n))
+			}
+			c.printf("\t%v = %v\n", joinNames(res), call)
+		default:
+			c.printf("\t%v = %v\n", c.name(instr), call)
+		}
+	case *ssa.If:
+		b := instr.Block()
+		c.printf("\tif %v {\n", c.name(instr.Cond))
+		c.edge(b, b.Succs[0], "\t\t")
+		c.printf("\t}\n")
+		c.edge(b, b.Succs[1], "\t")
+	case *ssa.Jump:
+		b := instr.Block()
+		c.edge(b, b.Succs[0], "\t")
+	case *ssa.Return:
+		var res []string
+		for _, v := range instr.Results {
+			res = append(res, c.name(v))
+		}
+		c.printf("\treturn %v\n", joinNames(res))
+	}
+}
+
+// edge assign the phis of succ by values from b and jump to succ.
+func (c *aotCompiler) edge(b, succ *ssa.BasicBlock, indent string) {
+	var pred int
+	for pred < len(succ.Preds) && succ.Preds[pred] != b {
+		pred++
+	}
+	var phis, values []string
+	for _, instr := range succ.Instrs {
+		phi, ok := instr.(*ssa.Phi)
+		if !ok {
+			break
+		}
+		phis = append(phis, c.name(phi))
+		values = append(values, c.name(phi.Edges[pred]))
+	}
+	if len(phis) > 0 {
+		c.printf("%v%v = %v\n", indent, joinNames(phis), joinNames(values))
+	}
+	c.printf("%vgoto b%v\n", indent, succ.Index)
+}
+
+func joinNames(names []string) string {
+	var buf bytes.Buffer
+	for n, name := range names {
+		if n > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(name)
+	}
+	return buf.String()
+}
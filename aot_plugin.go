@@ -0,0 +1,34 @@
+//go:build igop_plugin
+// +build igop_plugin
+
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import "plugin"
+
+func init() {
+	openPlugin = func(file string) (func(sym string) (interface{}, error), error) {
+		p, err := plugin.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		return func(sym string) (interface{}, error) {
+			return p.Lookup(sym)
+		}, nil
+	}
+}
@@ -76,7 +76,7 @@ func (inter *Interp) callBuiltin(caller *frame, fn *ssa.Builtin, args []value, s
 					continue
 				}
 			}
-			writevalue(&buf, arg, inter.ctx.Mode&EnablePrintAny != 0)
+			writevalue(&buf, arg, inter.conf.mode&EnablePrintAny != 0)
 		}
 		if ln {
 			buf.WriteRune('\n')
@@ -259,7 +259,7 @@ func (inter *Interp) callBuiltinDiscardsResult(caller *frame, fn *ssa.Builtin, a
 					continue
 				}
 			}
-			writevalue(&buf, arg, inter.ctx.Mode&EnablePrintAny != 0)
+			writevalue(&buf, arg, inter.conf.mode&EnablePrintAny != 0)
 		}
 		if ln {
 			buf.WriteRune('\n')
@@ -379,7 +379,7 @@ func (interp *Interp) callBuiltinByStack(caller *frame, fn string, ssaArgs []ssa
 					continue
 				}
 			}
-			writevalue(&buf, arg, interp.ctx.Mode&EnablePrintAny != 0)
+			writevalue(&buf, arg, interp.conf.mode&EnablePrintAny != 0)
 		}
 		if ln {
 			buf.WriteRune('\n')
@@ -457,6 +457,10 @@ func (interp *Interp) callBuiltinByStack(caller *frame, fn string, ssaArgs []ssa
 		}
 		caller.setReg(ir, recv)
 
+	case "ssa:deferstack":
+		// defer stack of range-over-func body is the caller frame
+		caller.setReg(ir, caller)
+
 	case "Add":
 		arg0 := caller.reg(ia[0])
 		arg1 := caller.reg(ia[1])
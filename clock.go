@@ -0,0 +1,370 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"reflect"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+)
+
+// clockSettle is the time of all goroutines keep blocked to auto advance.
+var clockSettle = time.Millisecond
+
+type vtimer struct {
+	when   time.Time
+	period time.Duration // ticker period
+	seq    int           // order of timers at same time
+	ch     chan time.Time
+	fn     func() // AfterFunc
+	active bool
+}
+
+// VirtualClock is the virtual clock of time for interpreted program, the
+// time only changes by Advance, or auto advance to the next timer when all
+// goroutines of interp are blocked. In DeterministicSchedule mode the auto
+// advance is driven by the scheduler.
+type VirtualClock struct {
+	mu       sync.Mutex
+	now      time.Time
+	timers   []*vtimer
+	seq      int
+	byTimer  map[uintptr]*vtimer // *time.Timer -> vtimer, delete by finalizer
+	byTicker map[uintptr]*vtimer // *time.Ticker -> vtimer, delete by finalizer
+	auto     bool
+	interp   *Interp
+	idle     int32  // number of goroutines blocked
+	wakeups  uint32 // number of goroutines wake up
+	checking int32  // checking auto advance
+}
+
+// NewVirtualClock create virtual clock start at start time.
+func NewVirtualClock(start time.Time) *VirtualClock {
+	return &VirtualClock{
+		now:      start,
+		byTimer:  make(map[uintptr]*vtimer),
+		byTicker: make(map[uintptr]*vtimer),
+	}
+}
+
+// SetAutoAdvance set auto advance to the next timer when all goroutines of
+// interp are blocked.
+func (c *VirtualClock) SetAutoAdvance(b bool) {
+	c.mu.Lock()
+	c.auto = b
+	c.mu.Unlock()
+	c.check()
+}
+
+// Now return the current virtual time.
+func (c *VirtualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance advance the virtual time by d and fire the expired timers in order.
+func (c *VirtualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.advanceTo(c.now.Add(d))
+}
+
+// AdvanceNext advance the virtual time to the next timer and fire it, return
+// false if no timer.
+func (c *VirtualClock) AdvanceNext() bool {
+	c.mu.Lock()
+	if len(c.timers) == 0 {
+		c.mu.Unlock()
+		return false
+	}
+	c.advanceTo(c.timers[0].when)
+	return true
+}
+
+// advanceTo fire timers before t, must hold the lock.
+func (c *VirtualClock) advanceTo(t time.Time) {
+	var fns []func()
+	for len(c.timers) > 0 && !c.timers[0].when.After(t) {
+		vt := c.timers[0]
+		c.now = vt.when
+		if vt.fn != nil {
+			fns = append(fns, vt.fn)
+		} else {
+			select {
+			case vt.ch <- c.now:
+			default:
+			}
+		}
+		if vt.period > 0 {
+			vt.when = vt.when.Add(vt.period)
+			c.seq++
+			vt.seq = c.seq
+		} else {
+			vt.active = false
+			c.timers = c.timers[1:]
+		}
+		c.sortTimers()
+	}
+	if t.After(c.now) {
+		c.now = t
+	}
+	c.mu.Unlock()
+	for _, fn := range fns {
+		fn()
+	}
+}
+
+func (c *VirtualClock) sortTimers() {
+	sort.Slice(c.timers, func(i, j int) bool {
+		ti, tj := c.timers[i], c.timers[j]
+		if ti.when.Equal(tj.when) {
+			return ti.seq < tj.seq
+		}
+		return ti.when.Before(tj.when)
+	})
+}
+
+// start add the timer fire after d, must hold the lock.
+func (c *VirtualClock) start(vt *vtimer, d time.Duration) {
+	if vt.active {
+		c.remove(vt)
+	}
+	c.seq++
+	vt.seq = c.seq
+	vt.when = c.now.Add(d)
+	vt.active = true
+	c.timers = append(c.timers, vt)
+	c.sortTimers()
+}
+
+// remove the timer, must hold the lock.
+func (c *VirtualClock) remove(vt *vtimer) bool {
+	if !vt.active {
+		return false
+	}
+	for i, v := range c.timers {
+		if v == vt {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			break
+		}
+	}
+	vt.active = false
+	return true
+}
+
+func (c *VirtualClock) newTimer(d time.Duration, fn func()) *time.Timer {
+	vt := &vtimer{fn: fn}
+	t := &time.Timer{}
+	if fn == nil {
+		vt.ch = make(chan time.Time, 1)
+		t.C = vt.ch
+	}
+	c.mu.Lock()
+	c.start(vt, d)
+	c.byTimer[uintptr(unsafe.Pointer(t))] = vt
+	c.mu.Unlock()
+	runtime.SetFinalizer(t, func(t *time.Timer) {
+		c.mu.Lock()
+		delete(c.byTimer, uintptr(unsafe.Pointer(t)))
+		c.mu.Unlock()
+	})
+	c.check()
+	return t
+}
+
+func (c *VirtualClock) newTicker(d time.Duration) *time.Ticker {
+	if d <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+	vt := &vtimer{ch: make(chan time.Time, 1), period: d}
+	t := &time.Ticker{C: vt.ch}
+	c.mu.Lock()
+	c.start(vt, d)
+	c.byTicker[uintptr(unsafe.Pointer(t))] = vt
+	c.mu.Unlock()
+	runtime.SetFinalizer(t, func(t *time.Ticker) {
+		c.mu.Lock()
+		delete(c.byTicker, uintptr(unsafe.Pointer(t)))
+		c.mu.Unlock()
+	})
+	c.check()
+	return t
+}
+
+func (c *VirtualClock) stopTimer(t *time.Timer) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	vt, ok := c.byTimer[uintptr(unsafe.Pointer(t))]
+	if !ok {
+		return t.Stop()
+	}
+	return c.remove(vt)
+}
+
+func (c *VirtualClock) resetTimer(t *time.Timer, d time.Duration) bool {
+	c.mu.Lock()
+	vt, ok := c.byTimer[uintptr(unsafe.Pointer(t))]
+	if !ok {
+		c.mu.Unlock()
+		return t.Reset(d)
+	}
+	active := vt.active
+	c.start(vt, d)
+	c.mu.Unlock()
+	c.check()
+	return active
+}
+
+func (c *VirtualClock) stopTicker(t *time.Ticker) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	vt, ok := c.byTicker[uintptr(unsafe.Pointer(t))]
+	if !ok {
+		t.Stop()
+		return
+	}
+	c.remove(vt)
+}
+
+func (c *VirtualClock) resetTicker(t *time.Ticker, d time.Duration) {
+	if d <= 0 {
+		panic("non-positive interval for Ticker.Reset")
+	}
+	c.mu.Lock()
+	vt, ok := c.byTicker[uintptr(unsafe.Pointer(t))]
+	if !ok {
+		c.mu.Unlock()
+		t.Reset(d)
+		return
+	}
+	vt.period = d
+	c.start(vt, d)
+	c.mu.Unlock()
+	c.check()
+}
+
+// block record the goroutine blocked for auto advance.
+func (c *VirtualClock) block() *VirtualClock {
+	atomic.AddInt32(&c.idle, 1)
+	c.check()
+	return c
+}
+
+func (c *VirtualClock) unblock(*VirtualClock) {
+	atomic.AddUint32(&c.wakeups, 1)
+	atomic.AddInt32(&c.idle, -1)
+}
+
+func (c *VirtualClock) allIdle() bool {
+	c.mu.Lock()
+	ok := c.auto && c.interp != nil && c.interp.sched == nil && len(c.timers) > 0
+	c.mu.Unlock()
+	return ok && atomic.LoadInt32(&c.idle) == atomic.LoadInt32(&c.interp.goroutines)
+}
+
+// advanceIdle advance to the next timer by the scheduler when all goroutines
+// are blocked, report whether the clock is advanced.
+func (c *VirtualClock) advanceIdle() bool {
+	c.mu.Lock()
+	if !c.auto || len(c.timers) == 0 {
+		c.mu.Unlock()
+		return false
+	}
+	c.advanceTo(c.timers[0].when)
+	return true
+}
+
+// check start auto advance if all goroutines are blocked.
+func (c *VirtualClock) check() {
+	if !c.allIdle() || !atomic.CompareAndSwapInt32(&c.checking, 0, 1) {
+		return
+	}
+	go func() {
+		for c.allIdle() {
+			wakeups := atomic.LoadUint32(&c.wakeups)
+			select {
+			case <-time.After(clockSettle):
+			case <-c.interp.chabort:
+				atomic.StoreInt32(&c.checking, 0)
+				return
+			}
+			if c.allIdle() && atomic.LoadUint32(&c.wakeups) == wakeups {
+				c.AdvanceNext()
+			}
+		}
+		atomic.StoreInt32(&c.checking, 0)
+		// goroutines blocked before reset checking
+		c.check()
+	}()
+}
+
+// SetVirtualClock replace time of interpreted program by the virtual clock.
+func (ctx *Context) SetVirtualClock(c *VirtualClock) {
+	ctx.clock = c
+	ctx.RegisterExternal("time.Now", c.Now)
+	ctx.RegisterExternal("time.Since", func(t time.Time) time.Duration {
+		return c.Now().Sub(t)
+	})
+	ctx.RegisterExternal("time.Until", func(t time.Time) time.Duration {
+		return t.Sub(c.Now())
+	})
+	ctx.RegisterExternal("time.Sleep", func(fr *frame, d time.Duration) {
+		if d <= 0 {
+			return
+		}
+		fr.interp.chanRecv(fr, reflect.ValueOf(c.newTimer(d, nil).C))
+	})
+	ctx.RegisterExternal("time.After", func(d time.Duration) <-chan time.Time {
+		return c.newTimer(d, nil).C
+	})
+	ctx.RegisterExternal("time.Tick", func(d time.Duration) <-chan time.Time {
+		if d <= 0 {
+			return nil
+		}
+		return c.newTicker(d).C
+	})
+	ctx.RegisterExternal("time.NewTimer", func(d time.Duration) *time.Timer {
+		return c.newTimer(d, nil)
+	})
+	ctx.RegisterExternal("time.NewTicker", c.newTicker)
+	ctx.RegisterExternal("time.AfterFunc", func(fr *frame, d time.Duration, f func()) *time.Timer {
+		interp := fr.interp
+		return c.newTimer(d, func() {
+			atomic.AddInt32(&interp.goroutines, 1)
+			var sg *schedG
+			if s := interp.sched; s != nil {
+				sg = s.spawn()
+			}
+			go func() {
+				if sg != nil {
+					interp.sched.start(sg)
+					defer interp.sched.exit(sg)
+				}
+				defer atomic.AddInt32(&interp.goroutines, -1)
+				f()
+			}()
+		})
+	})
+	ctx.RegisterExternal("(*time.Timer).Stop", c.stopTimer)
+	ctx.RegisterExternal("(*time.Timer).Reset", c.resetTimer)
+	ctx.RegisterExternal("(*time.Ticker).Stop", c.stopTicker)
+	ctx.RegisterExternal("(*time.Ticker).Reset", c.resetTicker)
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/goplus/igop/cmd/internal/base"
 	"github.com/goplus/igop/cmd/internal/build"
+	"github.com/goplus/igop/cmd/internal/doc"
 	"github.com/goplus/igop/cmd/internal/export"
 	"github.com/goplus/igop/cmd/internal/help"
 	"github.com/goplus/igop/cmd/internal/repl"
@@ -47,6 +48,7 @@ func init() {
 		repl.Cmd,
 		version.Cmd,
 		export.Cmd,
+		doc.Cmd,
 	}
 }
 
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"flag"
 	"fmt"
 	"go/build"
 	"io/ioutil"
@@ -9,6 +10,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -136,6 +138,7 @@ func init() {
 var (
 	goCmd    string
 	gossaCmd string
+	optLevel = flag.Int("O", 0, "level of SSA optimization of igop run")
 )
 
 func init() {
@@ -153,7 +156,7 @@ func init() {
 func runCommand(input string, chkout bool) bool {
 	fmt.Println("Input:", input)
 	start := time.Now()
-	cmd := exec.Command(gossaCmd, "run", "-exp-gc", input)
+	cmd := exec.Command(gossaCmd, "run", "-exp-gc", "-O", strconv.Itoa(*optLevel), input)
 	data, err := cmd.CombinedOutput()
 	if len(data) > 0 {
 		fmt.Println(string(data))
@@ -258,6 +261,7 @@ func execRunoutput(input string) (string, error) {
 }
 
 func main() {
+	flag.Parse()
 	dir, runs, runoutput := getGorootTestRuns()
 	var failures []string
 	start := time.Now()
@@ -19,6 +19,7 @@ package base
 import (
 	"go/build"
 	"runtime"
+	"strconv"
 	"strings"
 
 	"github.com/goplus/igop/load"
@@ -27,12 +28,25 @@ import (
 var (
 	BuildContext = defaultContext()
 	// BuildMod         string // -mod flag
-	BuildModExplicit bool // whether -mod was set explicitly
-	BuildX           bool // -x flag
-	BuildV           bool // -v flag
-	BuildSSA         bool // -ssa flag
-	DebugSSATrace    bool // -ssa-trace flag
-	ExperimentalGC   bool // -exp-gc flag experimental support runtime.GC
+	BuildModExplicit bool   // whether -mod was set explicitly
+	BuildX           bool   // -x flag
+	BuildV           bool   // -v flag
+	BuildSSA         bool   // -ssa flag
+	DebugSSATrace    bool   // -ssa-trace flag
+	ExperimentalGC   bool   // -exp-gc flag experimental support runtime.GC
+	AutoExport       bool   // -autoexport flag export missing packages on demand
+	CheckDeadlock    bool   // -deadlock flag check all goroutines are asleep
+	CheckDataRace    bool   // -race flag check data race of goroutines
+	CheckLeak        bool   // -leakcheck flag check goroutines leak after test
+	ScheduleSeed     int64  // -schedseed flag seed of deterministic schedule
+	Schedule         bool   // whether -schedseed was set
+	RecordFile       string // -record flag file of record trace
+	ReplayFile       string // -replay flag file of replay trace
+	NoSuperInstr     bool   // -nosuperinstr flag disable fused superinstructions
+	OptLevel         int    // -O flag level of SSA optimization
+	NoInline         bool   // -noinline flag disable inline small leaf functions
+	EagerCompile     bool   // -eager flag compile all functions before run
+	MaxDepth         int    // -maxdepth flag max depth of call stack
 )
 
 func defaultContext() build.Context {
@@ -49,6 +63,17 @@ const (
 	OmitSSAFlag
 	OmitSSATraceFlag
 	OmitExperimentalGCFlag
+	OmitAutoExportFlag
+	OmitDeadlockFlag
+	OmitRaceFlag
+	OmitLeakCheckFlag
+	OmitScheduleFlag
+	OmitRecordFlag
+	OmitSuperInstrFlag
+	OmitOptFlag
+	OmitInlineFlag
+	OmitEagerFlag
+	OmitMaxDepthFlag
 )
 
 // AddBuildFlags adds the flags common to the build, run, and test commands.
@@ -69,6 +94,40 @@ func AddBuildFlags(cmd *Command, mask BuildFlagMask) {
 	if mask&OmitExperimentalGCFlag != 0 {
 		cmd.Flag.BoolVar(&ExperimentalGC, "exp-gc", false, "experimental support runtime.GC")
 	}
+	if mask&OmitAutoExportFlag != 0 {
+		cmd.Flag.BoolVar(&AutoExport, "autoexport", false, "export missing packages on demand, load by plugin or module source.")
+	}
+	if mask&OmitDeadlockFlag != 0 {
+		cmd.Flag.BoolVar(&CheckDeadlock, "deadlock", false, "report deadlock when all goroutines are asleep on channel operations.")
+	}
+	if mask&OmitRaceFlag != 0 {
+		cmd.Flag.BoolVar(&CheckDataRace, "race", false, "enable data race detection.")
+	}
+	if mask&OmitScheduleFlag != 0 {
+		cmd.Flag.Var(scheduleSeedFlag{value: &ScheduleSeed, explicit: &Schedule}, "schedseed", "schedule goroutines deterministic by the seed for reproducible runs.")
+	}
+	if mask&OmitLeakCheckFlag != 0 {
+		cmd.Flag.BoolVar(&CheckLeak, "leakcheck", false, "report goroutines still running after each test and tests finish.")
+	}
+	if mask&OmitRecordFlag != 0 {
+		cmd.Flag.StringVar(&RecordFile, "record", "", "record nondeterministic inputs of program to the trace file.")
+		cmd.Flag.StringVar(&ReplayFile, "replay", "", "replay nondeterministic inputs of program from the trace file written by -record.")
+	}
+	if mask&OmitSuperInstrFlag != 0 {
+		cmd.Flag.BoolVar(&NoSuperInstr, "nosuperinstr", false, "disable fused superinstructions for debugging.")
+	}
+	if mask&OmitOptFlag != 0 {
+		cmd.Flag.IntVar(&OptLevel, "O", 0, "level of SSA optimization: 0 none, 1 fold consts and remove dead code, 2 also elide bounds checks.")
+	}
+	if mask&OmitInlineFlag != 0 {
+		cmd.Flag.BoolVar(&NoInline, "noinline", false, "disable inline small leaf functions into caller.")
+	}
+	if mask&OmitEagerFlag != 0 {
+		cmd.Flag.BoolVar(&EagerCompile, "eager", false, "compile all functions before run instead of on the first call.")
+	}
+	if mask&OmitMaxDepthFlag != 0 {
+		cmd.Flag.IntVar(&MaxDepth, "maxdepth", 0, "max depth of call stack to report stack overflow: 0 default 500000, negative no limit.")
+	}
 	cmd.Flag.Var((*tagsFlag)(&BuildContext.BuildTags), "tags", "a comma-separated list of build tags to consider satisfied during the build")
 }
 
@@ -111,3 +170,26 @@ func (f explicitStringFlag) Set(v string) error {
 	}
 	return nil
 }
+
+// scheduleSeedFlag is int64 flag, it also tracks whether the seed was set.
+type scheduleSeedFlag struct {
+	value    *int64
+	explicit *bool
+}
+
+func (f scheduleSeedFlag) String() string {
+	if f.value == nil {
+		return "0"
+	}
+	return strconv.FormatInt(*f.value, 10)
+}
+
+func (f scheduleSeedFlag) Set(v string) error {
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return err
+	}
+	*f.value = n
+	*f.explicit = true
+	return nil
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package doc implements the “igop doc” command.
+package doc
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/goplus/igop"
+	"github.com/goplus/igop/cmd/internal/base"
+)
+
+// -----------------------------------------------------------------------------
+
+// Cmd - igop doc
+var Cmd = &base.Command{
+	UsageLine: "igop doc [-deps] pkg[.Symbol[.Method]]",
+	Short:     "show documentation for register package or symbol",
+}
+
+var (
+	flag        = &Cmd.Flag
+	flagDocDeps bool
+)
+
+func init() {
+	Cmd.Run = docCmd
+	flag.BoolVar(&flagDocDeps, "deps", false, "print the imports of package")
+}
+
+func docCmd(cmd *base.Command, args []string) {
+	if err := flag.Parse(args); err != nil {
+		os.Exit(2)
+	}
+	if flag.NArg() != 1 {
+		cmd.Usage(os.Stderr)
+		os.Exit(2)
+	}
+	path, sym, method, found := igop.LookupSymbol(flag.Arg(0))
+	if !found {
+		fmt.Fprintf(os.Stderr, "doc: not found package %v\n", flag.Arg(0))
+		os.Exit(1)
+	}
+	info, _ := igop.LookupPackageInfo(path)
+	if sym == "" {
+		fmt.Print(info)
+		if flagDocDeps {
+			fmt.Println()
+			for _, dep := range info.Deps {
+				fmt.Printf("import %q\n", dep)
+			}
+		}
+		return
+	}
+	s, ok := info.Lookup(sym)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "doc: not found symbol %v.%v\n", info.Name, sym)
+		os.Exit(1)
+	}
+	if method == "" {
+		fmt.Println(s)
+		return
+	}
+	for _, m := range s.Methods {
+		if m.Name == method {
+			if s.Kind == igop.SymbolInterface {
+				fmt.Printf("func (%v.%v) %v%v\n", info.Name, sym, m.Name, strings.TrimPrefix(m.Type.String(), "func"))
+			} else {
+				fmt.Printf("func (%v) %v%v\n", m.Type.In(0), m.Name, strings.TrimPrefix(funcType(m), "func"))
+			}
+			return
+		}
+	}
+	fmt.Fprintf(os.Stderr, "doc: not found method %v.%v.%v\n", info.Name, sym, method)
+	os.Exit(1)
+}
+
+// funcType return the method type without receiver.
+func funcType(m reflect.Method) string {
+	typ := m.Type
+	var ins []string
+	for i := 1; i < typ.NumIn(); i++ {
+		if i == typ.NumIn()-1 && typ.IsVariadic() {
+			ins = append(ins, "..."+typ.In(i).Elem().String())
+		} else {
+			ins = append(ins, typ.In(i).String())
+		}
+	}
+	var outs []string
+	for i := 0; i < typ.NumOut(); i++ {
+		outs = append(outs, typ.Out(i).String())
+	}
+	s := "func(" + strings.Join(ins, ", ") + ")"
+	switch len(outs) {
+	case 0:
+	case 1:
+		s += " " + outs[0]
+	default:
+		s += " (" + strings.Join(outs, ", ") + ")"
+	}
+	return s
+}
@@ -0,0 +1,461 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package export
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/build"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goplus/igop"
+	"golang.org/x/mod/module"
+)
+
+const autoModule = "igop_autoexport"
+
+// openPlugin open the plugin file, it is nil if build without tag
+// igop_plugin.
+var openPlugin func(file string) error
+
+// AutoImport export missing packages on demand. The generated package is
+// loaded by go plugin on linux, or interpreted from the module source.
+// The module version is resolved by go.mod of root, or latest if root
+// not require the module. The resolution is cached in CacheDir.
+type AutoImport struct {
+	CacheDir string // cache dir, default $UserCacheDir/igop/export
+	Plugin   bool   // load package by go plugin, need build with tag igop_plugin
+	Verbose  bool   // print go commands
+	mu       sync.Mutex
+	pkgs     map[string]map[string]*autoPackage // resolve key -> path -> package
+	prepared map[string]error                   // work dir -> host modules error
+}
+
+type autoPackage struct {
+	Dir     string // source dir
+	Module  string // module path, std for standard package
+	Version string // module version
+	Work    string // work module dir
+}
+
+// NewAutoImport create AutoImport, plugin is enabled on linux.
+func NewAutoImport() *AutoImport {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return &AutoImport{
+		CacheDir: filepath.Join(dir, "igop", "export"),
+		Plugin:   runtime.GOOS == "linux" && openPlugin != nil,
+	}
+}
+
+// Lookup implement (*igop.Context).Missing
+func (a *AutoImport) Lookup(root, path string) (dir string, found bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	pkgs, err := a.resolve(root, path)
+	if err != nil {
+		log.Printf("autoexport %v failed: %v\n", path, err)
+		return
+	}
+	p, ok := pkgs[path]
+	if !ok {
+		return
+	}
+	if a.Plugin {
+		err := a.loadPlugin(path, p)
+		if err == nil {
+			return "", true
+		}
+		if a.Verbose {
+			log.Printf("autoexport %v plugin failed, use source: %v\n", path, err)
+		}
+	}
+	return p.Dir, true
+}
+
+// resolve return the packages of path deps resolved by go.mod of root,
+// load from cache file or download.
+func (a *AutoImport) resolve(root, path string) (map[string]*autoPackage, error) {
+	gomod, _ := ioutil.ReadFile(findGoMod(root))
+	key := fmt.Sprintf("%x", sha1.Sum([]byte(string(gomod)+"\n"+path)))
+	if pkgs, ok := a.pkgs[key]; ok {
+		return pkgs, nil
+	}
+	file := filepath.Join(a.CacheDir, "resolve", key+".json")
+	var pkgs map[string]*autoPackage
+	if data, err := ioutil.ReadFile(file); err == nil && json.Unmarshal(data, &pkgs) == nil && validPackages(pkgs) {
+		if a.Verbose {
+			log.Printf("autoexport %v resolved by %v\n", path, file)
+		}
+	} else {
+		if pkgs, err = a.download(root, path); err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(pkgs)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeFile(filepath.Dir(file), filepath.Base(file), data); err != nil {
+			return nil, err
+		}
+	}
+	if a.pkgs == nil {
+		a.pkgs = make(map[string]map[string]*autoPackage)
+	}
+	a.pkgs[key] = pkgs
+	return pkgs, nil
+}
+
+// validPackages check the dirs of cached packages exist.
+func validPackages(pkgs map[string]*autoPackage) bool {
+	for _, p := range pkgs {
+		if _, err := os.Stat(p.Dir); err != nil {
+			return false
+		}
+	}
+	return len(pkgs) > 0
+}
+
+// findGoMod return the go.mod file of module contains dir.
+func findGoMod(dir string) string {
+	if dir == "" {
+		return ""
+	}
+	dir, _ = filepath.Abs(dir)
+	for {
+		file := filepath.Join(dir, "go.mod")
+		if _, err := os.Stat(file); err == nil {
+			return file
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+var (
+	hostGoOnce sync.Once
+	hostGoCmd  string
+	hostGoErr  error
+)
+
+// hostGo return the go command of GOROOT, the plugin must be built by the
+// same go version of host binary.
+func hostGo() (string, error) {
+	hostGoOnce.Do(func() {
+		gocmd := filepath.Join(runtime.GOROOT(), "bin", "go")
+		if _, err := os.Stat(gocmd); err != nil {
+			gocmd = "go"
+		}
+		cmd := exec.Command(gocmd, "env", "GOVERSION")
+		cmd.Env = append(os.Environ(), "GOTOOLCHAIN=local")
+		out, err := cmd.Output()
+		if err != nil {
+			hostGoErr = err
+			return
+		}
+		if v := strings.TrimSpace(string(out)); v != runtime.Version() {
+			hostGoErr = fmt.Errorf("go command %v is %v, want %v", gocmd, v, runtime.Version())
+			return
+		}
+		hostGoCmd = gocmd
+	})
+	return hostGoCmd, hostGoErr
+}
+
+func (a *AutoImport) goCommand(dir string, args ...string) ([]byte, error) {
+	gocmd, err := hostGo()
+	if err != nil {
+		return nil, err
+	}
+	if a.Verbose {
+		log.Println(gocmd, strings.Join(args, " "))
+	}
+	var stderr strings.Builder
+	cmd := exec.Command(gocmd, args...)
+	cmd.Dir = dir
+	cmd.Stderr = &stderr
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOTOOLCHAIN=local")
+	data, err := cmd.Output()
+	if err != nil && stderr.Len() > 0 {
+		err = errors.New(stderr.String())
+	}
+	return data, err
+}
+
+// rootModule return the module of path required by root, the replace is
+// the module path@version or absolute dir of replace.
+func (a *AutoImport) rootModule(root, path string) (mod, version, replace string) {
+	if findGoMod(root) == "" {
+		return
+	}
+	data, err := a.goCommand(root, "list", "-m", "-f",
+		"{{.Path}}={{.Version}}={{with .Replace}}{{if .Version}}{{.Path}}@{{.Version}}{{else}}{{.Dir}}{{end}}{{end}}", "all")
+	if err != nil {
+		if a.Verbose {
+			log.Printf("autoexport list modules of %v failed: %v\n", root, err)
+		}
+		return
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		ar := strings.Split(line, "=")
+		if len(ar) != 3 || ar[1] == "" || len(ar[0]) <= len(mod) {
+			continue
+		}
+		if path == ar[0] || strings.HasPrefix(path, ar[0]+"/") {
+			mod, version, replace = ar[0], ar[1], ar[2]
+		}
+	}
+	return
+}
+
+// download the package to work module of the module version and list
+// deps dir and version.
+func (a *AutoImport) download(root, path string) (map[string]*autoPackage, error) {
+	mod, version, replace := a.rootModule(root, path)
+	id := path + "@latest"
+	if mod != "" {
+		id = mod + "@" + version + "=" + replace
+	}
+	work := filepath.Join(a.CacheDir, "work", fmt.Sprintf("%x", sha1.Sum([]byte(id))))
+	unlock, err := lockDir(work)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	if _, err := os.Stat(filepath.Join(work, "go.mod")); err != nil {
+		if err := writeFile(work, "go.mod", []byte("module "+autoModule+"\n\ngo 1.16\n")); err != nil {
+			return nil, err
+		}
+	}
+	if mod != "" {
+		args := []string{"mod", "edit", "-require=" + mod + "@" + version}
+		if replace != "" {
+			args = append(args, "-replace="+mod+"="+replace)
+		}
+		_, err = a.goCommand(work, args...)
+	} else {
+		_, err = a.goCommand(work, "get", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	data, err := a.goCommand(work, "list", "-deps", "-e", "-f",
+		"{{.ImportPath}}={{.Dir}}={{if .Standard}}std{{else}}{{with .Module}}{{.Path}}={{.Version}}{{end}}{{end}}", path)
+	if err != nil {
+		return nil, err
+	}
+	pkgs := make(map[string]*autoPackage)
+	for _, line := range strings.Split(string(data), "\n") {
+		ar := strings.Split(line, "=")
+		if len(ar) < 3 || ar[1] == "" {
+			continue
+		}
+		p := &autoPackage{Dir: ar[1], Module: ar[2], Work: work}
+		if len(ar) == 4 {
+			p.Version = ar[3]
+		} else if p.Module == "std" {
+			p.Version = runtime.Version()
+		}
+		pkgs[ar[0]] = p
+	}
+	return pkgs, nil
+}
+
+// lockTimeout is the age of lock file as stale.
+const lockTimeout = 10 * time.Minute
+
+// lockDir lock the dir between processes by lock file.
+func lockDir(dir string) (unlock func(), err error) {
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return nil, err
+	}
+	file := filepath.Join(dir, ".lock")
+	for start := time.Now(); ; {
+		f, err := os.OpenFile(file, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0666)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(file) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+		if info, err := os.Stat(file); err == nil && time.Since(info.ModTime()) > lockTimeout {
+			os.Remove(file)
+			continue
+		}
+		if time.Since(start) > lockTimeout {
+			return nil, fmt.Errorf("lock %v timeout", dir)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// prepare require the modules of host binary, plugin must be same version.
+func (a *AutoImport) prepare(work string) error {
+	if err, ok := a.prepared[work]; ok {
+		return err
+	}
+	if a.prepared == nil {
+		a.prepared = make(map[string]error)
+	}
+	err := a.requireHost(work)
+	a.prepared[work] = err
+	return err
+}
+
+func (a *AutoImport) requireHost(work string) error {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return errors.New("not found build info")
+	}
+	var args []string
+	deps := append([]*debug.Module{&info.Main}, info.Deps...)
+	for _, m := range deps {
+		if m.Path == "github.com/goplus/igop" && (m.Version == "" || m.Version == "(devel)") {
+			return errors.New("unknown igop module version")
+		}
+		if m.Path == "" || m.Version == "" || m.Version == "(devel)" {
+			continue
+		}
+		args = append(args, "-require="+m.Path+"@"+m.Version)
+		if r := m.Replace; r != nil {
+			if r.Version != "" {
+				args = append(args, "-replace="+m.Path+"="+r.Path+"@"+r.Version)
+			} else {
+				args = append(args, "-replace="+m.Path+"="+r.Path)
+			}
+		}
+	}
+	if len(args) > 0 {
+		_, err := a.goCommand(work, append([]string{"mod", "edit"}, args...)...)
+		return err
+	}
+	return nil
+}
+
+// hostID return the hash of go version and modules of host binary, the
+// plugin cached is only loaded by the same host.
+func hostID() (string, error) {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "", errors.New("not found build info")
+	}
+	h := sha1.New()
+	fmt.Fprintln(h, runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	deps := append([]*debug.Module{&info.Main}, info.Deps...)
+	for _, m := range deps {
+		fmt.Fprintln(h, m.Path, m.Version, m.Sum)
+		if r := m.Replace; r != nil {
+			fmt.Fprintln(h, "=>", r.Path, r.Version, r.Sum)
+		}
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// loadPlugin export package and build plugin cached by module version.
+func (a *AutoImport) loadPlugin(path string, p *autoPackage) error {
+	if openPlugin == nil {
+		return errors.New("plugin not supported, build with tag igop_plugin")
+	}
+	if p.Version == "" {
+		return fmt.Errorf("unknown version of module %v", p.Module)
+	}
+	epath, err := module.EscapePath(path)
+	if err != nil {
+		return err
+	}
+	host, err := hostID()
+	if err != nil {
+		return err
+	}
+	so := filepath.Join(a.CacheDir, "plugin", host, epath, p.Version+".so")
+	if _, err := os.Stat(so); err != nil {
+		if err := a.buildPlugin(path, p.Work, so); err != nil {
+			return err
+		}
+	}
+	if err := openPlugin(so); err != nil {
+		return err
+	}
+	if _, ok := igop.LookupPackage(path); !ok {
+		return fmt.Errorf("plugin %v not register package", so)
+	}
+	return nil
+}
+
+func (a *AutoImport) buildPlugin(path string, work string, so string) error {
+	unlock, err := lockDir(work)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if err := a.prepare(work); err != nil {
+		return err
+	}
+	ctx := build.Default
+	ctx.Dir = work
+	prog := NewProgram(&ctx)
+	prog.hybrid = true
+	if err := prog.Load([]string{path}); err != nil {
+		return err
+	}
+	e, err := prog.ExportPkg(path, "q")
+	if err != nil {
+		return err
+	}
+	data, err := exportPkg(e, "q", "", nil)
+	if err != nil {
+		return err
+	}
+	id := fmt.Sprintf("p%x", sha1.Sum([]byte(path)))
+	if err := writeFile(filepath.Join(work, "export", id), "export.go", data); err != nil {
+		return err
+	}
+	main := fmt.Sprintf("package main\n\nimport _ %q\n", autoModule+"/export/"+id)
+	if err := writeFile(filepath.Join(work, "plugin", id), "main.go", []byte(main)); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(so), 0777); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(so), "build-*.so")
+	if err != nil {
+		return err
+	}
+	tmp.Close()
+	defer os.Remove(tmp.Name())
+	if _, err := a.goCommand(work, "build", "-buildmode=plugin", "-o", tmp.Name(), "./plugin/"+id); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), so)
+}
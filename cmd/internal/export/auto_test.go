@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAutoImport(t *testing.T) {
+	tmp := t.TempDir()
+	write := func(file string, data string) {
+		if err := writeFile(filepath.Join(tmp, filepath.Dir(file)), filepath.Base(file), []byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("lib/go.mod", "module example.com/lib\n\ngo 1.16\n")
+	write("lib/sub/sub.go", "package sub\n\nfunc Name() string { return \"sub\" }\n")
+	write("root/go.mod", "module example.com/root\n\ngo 1.16\n\nrequire example.com/lib v1.0.0\n\nreplace example.com/lib => ../lib\n")
+	write("root/main.go", "package main\n\nimport \"example.com/lib/sub\"\n\nfunc main() { println(sub.Name()) }\n")
+	root := filepath.Join(tmp, "root")
+	want := filepath.Join(tmp, "lib", "sub")
+
+	a := NewAutoImport()
+	a.CacheDir = filepath.Join(tmp, "cache")
+	a.Plugin = false
+	dir, found := a.Lookup(root, "example.com/lib/sub")
+	if !found || dir != want {
+		t.Fatalf("lookup %v %v, want %v", dir, found, want)
+	}
+	p := a.pkgs[firstKey(a.pkgs)]["example.com/lib/sub"]
+	if p.Module != "example.com/lib" || p.Version != "v1.0.0" {
+		t.Fatalf("bad module %v@%v", p.Module, p.Version)
+	}
+
+	// resolution cached in CacheDir, no go command
+	path := os.Getenv("PATH")
+	os.Setenv("PATH", "")
+	defer os.Setenv("PATH", path)
+	if gocmd, err := hostGo(); err != nil || !filepath.IsAbs(gocmd) {
+		t.Fatalf("go command of GOROOT %v %v", gocmd, err)
+	}
+	b := NewAutoImport()
+	b.CacheDir = a.CacheDir
+	b.Plugin = false
+	if dir, found := b.Lookup(root, "example.com/lib/sub"); !found || dir != want {
+		t.Fatalf("cached lookup %v %v, want %v", dir, found, want)
+	}
+	if _, found := b.Lookup(root, "example.com/lib/none"); found {
+		t.Fatal("must not found")
+	}
+}
+
+func firstKey(m map[string]map[string]*autoPackage) string {
+	for k := range m {
+		return k
+	}
+	return ""
+}
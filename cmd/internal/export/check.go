@@ -0,0 +1,210 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package export
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/goplus/igop"
+)
+
+// Check compare the register packages with the installed packages for
+// each build context, report false if found any difference.
+func Check(pkgs []string, ctxList []*build.Context) bool {
+	if len(ctxList) == 0 {
+		ctxList = []*build.Context{nil}
+	}
+	ok := true
+	for _, ctx := range ctxList {
+		diffs, err := CheckPkgs(pkgs, ctx)
+		if err != nil {
+			log.Println(err)
+			ok = false
+			continue
+		}
+		for _, d := range diffs {
+			if d.IsEmpty() {
+				continue
+			}
+			ok = false
+			fmt.Printf("# %v (%v)\n%v", d.Path, contextName(ctx), d)
+		}
+	}
+	return ok
+}
+
+// CheckPkgs load packages by build context and compare with the export
+// files generated for the build context in outdir. Without outdir, compare
+// with the register packages built for host, so the build context must be
+// host GOOS and GOARCH.
+func CheckPkgs(pkgs []string, ctx *build.Context) ([]*igop.PackageDiff, error) {
+	if flagExportDir == "" && ctx != nil && (ctx.GOOS != runtime.GOOS || ctx.GOARCH != runtime.GOARCH) {
+		return nil, fmt.Errorf("check %v: register packages are built for %v_%v, set outdir to check export files",
+			contextName(ctx), runtime.GOOS, runtime.GOARCH)
+	}
+	prog := NewProgram(ctx)
+	if err := prog.Load(pkgs); err != nil {
+		return nil, err
+	}
+	var diffs []*igop.PackageDiff
+	for _, path := range pkgs {
+		if path == "unsafe" {
+			continue
+		}
+		info := prog.prog.Package(path)
+		if info == nil {
+			return nil, fmt.Errorf("not found path %v", path)
+		}
+		if flagExportDir != "" {
+			d, err := checkExportFile(prog, path, ctx)
+			if os.IsNotExist(err) {
+				log.Printf("check %v: not found export file %v\n", path, exportFileName(ctx))
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, d)
+			continue
+		}
+		pkg, found := igop.LookupPackage(path)
+		if !found {
+			log.Printf("check %v: not register package\n", path)
+			continue
+		}
+		diffs = append(diffs, igop.DiffPackage(pkg, info.Pkg))
+	}
+	return diffs, nil
+}
+
+// checkExportFile compare the export file of build context in outdir with
+// the export of package loaded by the build context.
+func checkExportFile(prog *Program, path string, ctx *build.Context) (*igop.PackageDiff, error) {
+	file := filepath.Join(flagExportDir, path, exportFileName(ctx))
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	reg, err := exportEntries(file, data)
+	if err != nil {
+		return nil, err
+	}
+	e, err := prog.ExportPkg(path, "q")
+	if err != nil {
+		return nil, err
+	}
+	src, err := exportPkg(e, "q", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	want, err := exportEntries(path, src)
+	if err != nil {
+		return nil, err
+	}
+	d := &igop.PackageDiff{Path: path}
+	for name, w := range want {
+		r, ok := reg[name]
+		switch {
+		case !ok:
+			d.Missing = append(d.Missing, w.kind+" "+name)
+		case r.kind != w.kind:
+			d.Changed = append(d.Changed, fmt.Sprintf("%v kind: register %v, want %v", name, r.kind, w.kind))
+		case r.value != w.value:
+			d.Changed = append(d.Changed, fmt.Sprintf("%v value: register %v, want %v", name, r.value, w.value))
+		}
+	}
+	for name := range reg {
+		if _, ok := want[name]; !ok {
+			d.Extra = append(d.Extra, name)
+		}
+	}
+	sort.Strings(d.Missing)
+	sort.Strings(d.Extra)
+	sort.Strings(d.Changed)
+	return d, nil
+}
+
+// exportKinds is the symbol kinds by the fields of igop.Package.
+var exportKinds = map[string]string{
+	"Interfaces":    "interface",
+	"NamedTypes":    "type",
+	"AliasTypes":    "alias",
+	"Vars":          "var",
+	"Funcs":         "func",
+	"TypedConsts":   "typed const",
+	"UntypedConsts": "untyped const",
+}
+
+type exportEntry struct {
+	kind  string
+	value string
+}
+
+// exportEntries parse the symbols of igop.Package in export file.
+func exportEntries(filename string, src []byte) (map[string]exportEntry, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), filename, src, 0)
+	if err != nil {
+		return nil, err
+	}
+	entries := make(map[string]exportEntry)
+	ast.Inspect(f, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok || exportKinds[key.Name] == "" {
+			return true
+		}
+		lit, ok := kv.Value.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		for _, elt := range lit.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				if name, err := strconv.Unquote(types.ExprString(kv.Key)); err == nil {
+					entries[name] = exportEntry{exportKinds[key.Name], types.ExprString(kv.Value)}
+				}
+			}
+		}
+		return false
+	})
+	return entries, nil
+}
+
+func contextName(ctx *build.Context) string {
+	if ctx == nil {
+		ctx = &build.Default
+	}
+	name := ctx.GOOS + "_" + ctx.GOARCH
+	if tags := strings.Join(ctx.BuildTags, ","); tags != "" {
+		name += " tags=" + tags
+	}
+	return name
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package export
+
+import (
+	"go/build"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheckExportFile(t *testing.T) {
+	path := "github.com/goplus/igop/testdata/hybrid"
+	dir := flagExportDir
+	flagExportDir = t.TempDir()
+	defer func() { flagExportDir = dir }()
+	ctx := build.Default
+	ctx.GOOS, ctx.GOARCH = "windows", "arm64"
+	ExportPkgs([]string{path}, &ctx)
+	diffs, err := CheckPkgs([]string{path}, &ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) != 1 || !diffs[0].IsEmpty() {
+		t.Fatalf("bad diffs %v", diffs)
+	}
+	file := filepath.Join(flagExportDir, path, "export_windows_arm64.go")
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lines []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if !strings.Contains(line, `"Add"`) {
+			lines = append(lines, line)
+		}
+	}
+	if err := writeFile(filepath.Dir(file), filepath.Base(file), []byte(strings.Join(lines, "\n"))); err != nil {
+		t.Fatal(err)
+	}
+	diffs, err = CheckPkgs([]string{path}, &ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := diffs[0].String(); s != "missing func Add\n" {
+		t.Fatalf("bad diff %q", s)
+	}
+}
@@ -37,6 +37,10 @@ var (
 	flagBuildTags      string
 	flagExportFileName string
 	flagExportSource   bool
+	flagExportHybrid   bool
+	flagExportLazy     bool
+	flagExportCheck    bool
+	flagExportThunk    bool
 )
 
 func init() {
@@ -46,6 +50,10 @@ func init() {
 	flag.StringVar(&flagBuildTags, "tags", "", "a comma-separated list of build tags")
 	flag.StringVar(&flagExportFileName, "filename", "export", "set export file name")
 	flag.BoolVar(&flagExportSource, "src", false, "export source mode")
+	flag.BoolVar(&flagExportHybrid, "hybrid", false, "export generic declarations as source and bind others to the compiled package")
+	flag.BoolVar(&flagExportLazy, "lazy", false, "export package by lazy loader, registered on first import")
+	flag.BoolVar(&flagExportThunk, "thunk", false, "export typed call thunks of funcs for calls without reflect")
+	flag.BoolVar(&flagExportCheck, "check", false, "check the register packages with the installed packages, report missing/extra/changed symbols")
 }
 
 // Cmd - igop build
@@ -85,6 +93,12 @@ func exportCmd(cmd *base.Command, args []string) {
 		flagExportFileName = "export"
 	}
 	ctxList := parserContextList(flagBuildContext)
+	if flagExportCheck {
+		if !Check(args, ctxList) {
+			os.Exit(1)
+		}
+		return
+	}
 	Export(args, ctxList)
 }
 
@@ -191,16 +205,19 @@ func ExportPkg(prog *Program, pkg string, ctx *build.Context) (string, error) {
 		return "stdout", nil
 	}
 	fpath := filepath.Join(flagExportDir, pkg)
-	var fname string
-	if ctx != nil {
-		fname = flagExportFileName + "_" + ctx.GOOS + "_" + ctx.GOARCH + ".go"
-	} else {
-		fname = flagExportFileName + ".go"
-	}
+	fname := exportFileName(ctx)
 	err = writeFile(fpath, fname, data)
 	return filepath.Join(fpath, fname), err
 }
 
+// exportFileName return the export file name of build context.
+func exportFileName(ctx *build.Context) string {
+	if ctx != nil {
+		return flagExportFileName + "_" + ctx.GOOS + "_" + ctx.GOARCH + ".go"
+	}
+	return flagExportFileName + ".go"
+}
+
 func parserContextList(list string) (ctxs []*build.Context) {
 	for _, info := range strings.Split(list, " ") {
 		info = strings.TrimSpace(info)
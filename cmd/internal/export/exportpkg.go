@@ -91,6 +91,15 @@ func exportPkg(pkg *Package, sname string, id string, tagList []string) ([]byte,
 			imports = append(imports, `_ "unsafe"`)
 		}
 	}
+	if len(pkg.Thunks) > 0 {
+		tmpl = thunkTemplate(tmpl)
+	}
+	if len(pkg.GenericSource) > 0 {
+		tmpl = genericTemplate(tmpl)
+	}
+	if flagExportLazy {
+		tmpl = lazyTemplate(tmpl)
+	}
 	r := strings.NewReplacer("$PKGNAME", pkg.Name,
 		"$IMPORTS", strings.Join(imports, "\n"),
 		"$PKGPATH", pkg.Path,
@@ -100,10 +109,12 @@ func exportPkg(pkg *Package, sname string, id string, tagList []string) ([]byte,
 		"$ALIASTYPES", joinList(pkg.AliasTypes),
 		"$VARS", joinList(pkg.Vars),
 		"$FUNCS", joinList(pkg.Funcs),
+		"$THUNKS", joinList(pkg.Thunks),
 		"$TYPEDCONSTS", joinList(pkg.TypedConsts),
 		"$UNTYPEDCONSTS", joinList(pkg.UntypedConsts),
 		"$TAGS", strings.Join(tagList, "\n"),
 		"$SOURCE", pkg.Source,
+		"$GENERIC", pkg.GenericSource,
 		"$LINKS", strings.Join(pkg.Links, "\n"),
 		"$ID", id)
 	src := r.Replace(tmpl)
@@ -114,6 +125,26 @@ func exportPkg(pkg *Package, sname string, id string, tagList []string) ([]byte,
 	return data, nil
 }
 
+// lazyTemplate convert RegisterPackage template to RegisterPackageLoader
+func lazyTemplate(tmpl string) string {
+	r := strings.NewReplacer("igop.RegisterPackage(&igop.Package {",
+		"igop.RegisterPackageLoader(\"$PKGPATH\", func() *igop.Package {\nreturn &igop.Package {",
+		"\n\t})\n}\n", "\n\t}\n})\n}\n")
+	return r.Replace(tmpl)
+}
+
+// thunkTemplate add typed call thunks of funcs to template
+func thunkTemplate(tmpl string) string {
+	return strings.Replace(tmpl, "\t\tFuncs: map[string]reflect.Value{$FUNCS},\n",
+		"\t\tFuncs: map[string]reflect.Value{$FUNCS},\n\t\tThunks: map[string]igop.CallThunk{$THUNKS},\n", 1)
+}
+
+// genericTemplate add generic source to template
+func genericTemplate(tmpl string) string {
+	return strings.Replace(tmpl, "\n\t})\n}\n",
+		"\n\t\tGenericSource: generic,\n\t})\n}\n", 1) + "\nvar generic = $GENERIC\n"
+}
+
 var template_pkg = `// export by github.com/goplus/igop/cmd/qexp
 
 $TAGS
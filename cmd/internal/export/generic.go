@@ -0,0 +1,291 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"log"
+	"sort"
+	"strconv"
+
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/loader"
+)
+
+// genericPkgName is the import name of register package in generic source.
+const genericPkgName = "_q"
+
+// genericOverride is the generic func source replaced for std funcs that
+// use unexported vars of package, deps is the unexported objects it used.
+type genericOverride struct {
+	deps []string
+	src  string
+}
+
+var genericOverrides = map[string]genericOverride{
+	"math/rand/v2.N": {[]string{"intType"}, `func N[Int intType](n Int) Int {
+	if n <= 0 {
+		panic("invalid argument to N")
+	}
+	return Int(` + genericPkgName + `.Uint64N(uint64(n)))
+}`},
+}
+
+type genericExporter struct {
+	info  *loader.PackageInfo
+	pkg   *types.Package
+	decls map[types.Object][]ast.Node // object -> specs or func decls, methods of type
+	toks  map[ast.Node]token.Token    // spec -> GenDecl token
+}
+
+// ExportGenerics export generic funcs and types as GenericSource, it use
+// the register package for exported objects. The generic declarations use
+// unexported vars or unexported members of exported types are skipped.
+func (p *Program) ExportGenerics(e *Package, info *loader.PackageInfo) {
+	g := &genericExporter{
+		info:  info,
+		pkg:   info.Pkg,
+		decls: make(map[types.Object][]ast.Node),
+		toks:  make(map[ast.Node]token.Token),
+	}
+	g.index()
+	var roots []types.Object
+	scope := g.pkg.Scope()
+	for _, name := range scope.Names() {
+		obj := scope.Lookup(name)
+		if _, ok := obj.(*types.Var); !ok && obj.Exported() && hasTypeParam(obj.Type()) {
+			roots = append(roots, obj)
+		}
+	}
+	deps := make(map[types.Object]map[types.Object]bool)
+	for _, root := range roots {
+		set := make(map[types.Object]bool)
+		if err := g.closure(root, set); err != nil {
+			log.Printf("skip typeparam %v: %v\n", root, err)
+			continue
+		}
+		deps[root] = set
+	}
+	// drop roots depend on skipped roots
+	for changed := true; changed; {
+		changed = false
+		for root, set := range deps {
+			for obj := range set {
+				if _, ok := deps[obj]; !ok && obj.Exported() {
+					log.Printf("skip typeparam %v: use skipped %v\n", root, obj.Name())
+					delete(deps, root)
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	used := make(map[types.Object]bool)
+	for _, set := range deps {
+		for obj := range set {
+			used[obj] = true
+		}
+	}
+	if len(used) == 0 {
+		return
+	}
+	var buf bytes.Buffer
+	if err := g.source(&buf, p.fset, used); err != nil {
+		log.Println("export generic source failed", err)
+		return
+	}
+	e.GenericSource = strconv.Quote(buf.String())
+}
+
+func (g *genericExporter) index() {
+	for _, file := range g.info.Files {
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				obj := g.info.Defs[d.Name]
+				if obj == nil {
+					continue
+				}
+				if recv := obj.Type().(*types.Signature).Recv(); recv != nil {
+					if named := namedOf(recv.Type()); named != nil {
+						g.decls[named.Obj()] = append(g.decls[named.Obj()], d)
+					}
+					continue
+				}
+				g.decls[obj] = append(g.decls[obj], d)
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					var names []*ast.Ident
+					switch s := spec.(type) {
+					case *ast.TypeSpec:
+						names = []*ast.Ident{s.Name}
+					case *ast.ValueSpec:
+						names = s.Names
+					}
+					for _, name := range names {
+						if obj := g.info.Defs[name]; obj != nil {
+							// spec before methods
+							g.decls[obj] = append([]ast.Node{spec}, g.decls[obj]...)
+							g.toks[spec] = d.Tok
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func namedOf(typ types.Type) *types.Named {
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, _ := typ.(*types.Named)
+	return named
+}
+
+// isGenericLocal check obj declared in generic source.
+func (g *genericExporter) isGenericLocal(obj types.Object) bool {
+	return obj.Pkg() == g.pkg && obj.Parent() == g.pkg.Scope() &&
+		(!obj.Exported() || hasTypeParam(obj.Type()))
+}
+
+// closure add obj and the package objects used by its declaration to set.
+func (g *genericExporter) closure(obj types.Object, set map[types.Object]bool) error {
+	if set[obj] {
+		return nil
+	}
+	set[obj] = true
+	if o, ok := genericOverrides[g.pkg.Path()+"."+obj.Name()]; ok {
+		for _, name := range o.deps {
+			if err := g.closure(g.pkg.Scope().Lookup(name), set); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	nodes, ok := g.decls[obj]
+	if !ok {
+		return fmt.Errorf("not found declaration of %v", obj.Name())
+	}
+	var err error
+	for _, node := range nodes {
+		if spec, ok := node.(*ast.ValueSpec); ok && g.toks[spec] == token.CONST && len(spec.Values) == 0 {
+			return fmt.Errorf("use implicit const %v", obj.Name())
+		}
+		ast.Inspect(node, func(n ast.Node) bool {
+			if err != nil {
+				return false
+			}
+			switch n := n.(type) {
+			case *ast.SelectorExpr:
+				if sel, ok := g.info.Selections[n]; ok && sel.Obj().Pkg() == g.pkg && !sel.Obj().Exported() {
+					if named := namedOf(sel.Recv()); named != nil && !g.isGenericLocal(named.Obj()) {
+						err = fmt.Errorf("use unexported %v.%v", named.Obj().Name(), sel.Obj().Name())
+					}
+				}
+			case *ast.Ident:
+				if n.Name == "iota" {
+					err = fmt.Errorf("use iota const %v", obj.Name())
+				} else if use := g.info.Uses[n]; use != nil && g.isGenericLocal(use) {
+					if _, ok := use.(*types.Var); ok {
+						err = fmt.Errorf("use unexported var %v", use.Name())
+					} else {
+						err = g.closure(use, set)
+					}
+				}
+			}
+			return err == nil
+		})
+	}
+	return err
+}
+
+// source write generic source of used objects, the exported non-generic
+// objects refer to register package.
+func (g *genericExporter) source(buf *bytes.Buffer, fset *token.FileSet, used map[types.Object]bool) error {
+	var objs []types.Object
+	for obj := range used {
+		objs = append(objs, obj)
+	}
+	sort.Slice(objs, func(i, j int) bool {
+		return objs[i].Pos() < objs[j].Pos()
+	})
+	imports := make(map[string]string)
+	var body bytes.Buffer
+	printed := make(map[ast.Node]bool)
+	for _, obj := range objs {
+		if o, ok := genericOverrides[g.pkg.Path()+"."+obj.Name()]; ok {
+			imports[g.pkg.Path()] = genericPkgName
+			fmt.Fprintf(&body, "\n%v\n", o.src)
+			continue
+		}
+		for _, node := range g.decls[obj] {
+			if printed[node] {
+				continue
+			}
+			printed[node] = true
+			node = astutil.Apply(node, func(c *astutil.Cursor) bool {
+				id, ok := c.Node().(*ast.Ident)
+				if !ok {
+					return true
+				}
+				switch use := g.info.Uses[id].(type) {
+				case nil:
+				case *types.PkgName:
+					imports[use.Imported().Path()] = use.Name()
+				default:
+					if use.Pkg() == g.pkg && use.Parent() == g.pkg.Scope() && !g.isGenericLocal(use) {
+						imports[g.pkg.Path()] = genericPkgName
+						c.Replace(&ast.SelectorExpr{X: ast.NewIdent(genericPkgName), Sel: ast.NewIdent(id.Name)})
+					}
+				}
+				return true
+			}, nil)
+			switch n := node.(type) {
+			case *ast.TypeSpec:
+				node = &ast.GenDecl{Tok: g.toks[n], Specs: []ast.Spec{n}}
+			case *ast.ValueSpec:
+				node = &ast.GenDecl{Tok: g.toks[n], Specs: []ast.Spec{n}}
+			}
+			body.WriteByte('\n')
+			if err := printer.Fprint(&body, fset, node); err != nil {
+				return err
+			}
+			body.WriteByte('\n')
+		}
+	}
+	paths := make([]string, 0, len(imports))
+	for path := range imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	fmt.Fprintf(buf, "package %v\n", g.pkg.Name())
+	if len(paths) > 0 {
+		buf.WriteString("\nimport (\n")
+		for _, path := range paths {
+			fmt.Fprintf(buf, "\t%v %q\n", imports[path], path)
+		}
+		buf.WriteString(")\n")
+	}
+	buf.Write(body.Bytes())
+	return nil
+}
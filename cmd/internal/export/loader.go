@@ -22,6 +22,7 @@ import (
 	"go/ast"
 	"go/build"
 	"go/constant"
+	"go/parser"
 	"go/printer"
 	"go/token"
 	"go/types"
@@ -33,9 +34,13 @@ import (
 )
 
 type Program struct {
-	prog *loader.Program
-	ctx  *build.Context
-	fset *token.FileSet
+	prog    *loader.Program
+	ctx     *build.Context
+	fset    *token.FileSet
+	exports map[string]bool
+	hybrid  bool                    // hybrid export generic package
+	links   map[string][]*types.Var // hybrid unexported vars by package path
+	err     error                   // hybrid export failed
 }
 
 func NewProgram(ctx *build.Context) *Program {
@@ -43,46 +48,153 @@ func NewProgram(ctx *build.Context) *Program {
 		ctx = &build.Default
 		ctx.BuildTags = strings.Split(flagBuildTags, ",")
 	}
-	return &Program{ctx: ctx, fset: token.NewFileSet()}
+	return &Program{ctx: ctx, fset: token.NewFileSet(), hybrid: flagExportHybrid}
 }
 
 func (p *Program) Load(pkgs []string) error {
 	var cfg loader.Config
 	cfg.Build = p.ctx
 	cfg.Fset = p.fset
-	if flagExportSource {
+	if flagExportSource || p.hybrid {
 		cfg.AfterTypeCheck = p.typeCheck
 	}
+	p.exports = make(map[string]bool)
+	p.links = make(map[string][]*types.Var)
+	p.err = nil
 	for _, pkg := range pkgs {
 		cfg.Import(pkg)
+		p.exports[pkg] = true
 	}
 	iprog, err := cfg.Load()
 	if err != nil {
 		return fmt.Errorf("conf.Load failed: %s", err)
 	}
+	if p.err != nil {
+		return p.err
+	}
 	p.prog = iprog
 	return nil
 }
 
 func (p *Program) typeCheck(info *loader.PackageInfo, files []*ast.File) {
+	hybrid := p.hybrid && p.exports[info.Pkg.Path()]
 	for _, file := range files {
 		for _, decl := range file.Decls {
-			if fn, ok := decl.(*ast.FuncDecl); ok {
-				if funcHasTypeParams(fn) {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				if funcHasTypeParams(d) {
 					continue
 				}
-				if recv := recvType(fn); recv != nil && !ast.IsExported(recv.Name) {
+				if recv := recvType(d); recv != nil && !ast.IsExported(recv.Name) {
 					continue
 				}
-				if !ast.IsExported(fn.Name.Name) {
+				if !ast.IsExported(d.Name.Name) {
 					continue
 				}
-				fn.Body = nil
+				d.Body = nil
+			case *ast.GenDecl:
+				if hybrid && d.Tok == token.VAR {
+					p.hybridVars(info, d)
+				}
 			}
 		}
 	}
 }
 
+// hybridVars drops the initializer of vars, the compiled package has run
+// it. The exported vars bind to the compiled package vars registered by
+// Vars, the unexported vars link to the compiled package vars by linkname,
+// so the native funcs and the interpreted source share the package state.
+// The package is rejected if an unexported var can not be linked.
+func (p *Program) hybridVars(info *loader.PackageInfo, d *ast.GenDecl) {
+	qualifier := func(other *types.Package) string {
+		if other == info.Pkg {
+			return ""
+		}
+		return other.Name()
+	}
+	var specs []ast.Spec
+	for _, spec := range d.Specs {
+		vs := spec.(*ast.ValueSpec)
+		for _, name := range vs.Names {
+			if name.Name == "_" {
+				continue
+			}
+			v := info.Defs[name].(*types.Var)
+			if !name.IsExported() {
+				if !linkable(info.Pkg, v.Type()) {
+					if p.err == nil {
+						p.err = fmt.Errorf("hybrid: var %v.%v of type %v can not link to compiled package",
+							info.Pkg.Path(), name.Name, v.Type())
+					}
+					continue
+				}
+				p.links[info.Pkg.Path()] = append(p.links[info.Pkg.Path()], v)
+			}
+			typ, err := parser.ParseExpr(types.TypeString(v.Type(), qualifier))
+			if err != nil {
+				log.Panicf("hybrid: parse var %v type failed: %v\n", name.Name, err)
+			}
+			specs = append(specs, &ast.ValueSpec{Doc: vs.Doc, Names: []*ast.Ident{name}, Type: typ})
+		}
+	}
+	if len(specs) != 1 && !d.Lparen.IsValid() {
+		d.Lparen, d.Rparen = d.TokPos, d.End()
+	}
+	d.Specs = specs
+}
+
+// linkable check typ can be declared by the export package, it only
+// refers predeclared types and exported types of pkg.
+func linkable(pkg *types.Package, typ types.Type) bool {
+	switch t := typ.(type) {
+	case *types.Basic:
+		return t.Kind() != types.UnsafePointer
+	case *types.Named:
+		obj := t.Obj()
+		if obj.Pkg() != nil && (obj.Pkg() != pkg || !obj.Exported()) {
+			return false
+		}
+		if args := typeArgs(t); args != nil {
+			for i := 0; i < args.Len(); i++ {
+				if !linkable(pkg, args.At(i)) {
+					return false
+				}
+			}
+		}
+		return true
+	case *types.Pointer:
+		return linkable(pkg, t.Elem())
+	case *types.Slice:
+		return linkable(pkg, t.Elem())
+	case *types.Array:
+		return linkable(pkg, t.Elem())
+	case *types.Chan:
+		return linkable(pkg, t.Elem())
+	case *types.Map:
+		return linkable(pkg, t.Key()) && linkable(pkg, t.Elem())
+	case *types.Signature:
+		for _, tuple := range []*types.Tuple{t.Params(), t.Results()} {
+			for i := 0; i < tuple.Len(); i++ {
+				if !linkable(pkg, tuple.At(i).Type()) {
+					return false
+				}
+			}
+		}
+		return true
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			if f := t.Field(i); !f.Exported() || !linkable(pkg, f.Type()) {
+				return false
+			}
+		}
+		return true
+	case *types.Interface:
+		return t.Empty()
+	}
+	return false
+}
+
 func recvType(fn *ast.FuncDecl) *ast.Ident {
 	if fn.Recv == nil {
 		return nil
@@ -168,11 +280,13 @@ type Package struct {
 	AliasTypes    []string
 	Vars          []string
 	Funcs         []string
+	Thunks        []string
 	Consts        []string
 	TypedConsts   []string
 	UntypedConsts []string
 	Links         []string
 	Source        string
+	GenericSource string
 	usedPkg       bool
 }
 
@@ -332,6 +446,16 @@ func (p *Program) ExportSource(e *Package, info *loader.PackageInfo) error {
 			}
 		}
 	}
+	qualifier := func(other *types.Package) string {
+		e.usedPkg = true
+		return "q"
+	}
+	for _, v := range p.links[pkgPath] {
+		lcName := "_" + v.Name()
+		links = append(links, fmt.Sprintf("//go:linkname %v %v.%v\nvar %v %v\n",
+			lcName, pkgPath, v.Name(), lcName, types.TypeString(v.Type(), qualifier)))
+		e.Vars = append(e.Vars, fmt.Sprintf("%q : reflect.ValueOf(&%v)", v.Name(), lcName))
+	}
 	var buf bytes.Buffer
 	err := printer.Fprint(&buf, p.fset, outf)
 	if err != nil {
@@ -375,19 +499,18 @@ func (p *Program) ExportPkg(path string, sname string) (*Package, error) {
 			e.usedPkg = true
 		case *types.Func:
 			if hasTypeParam(t.Type()) {
-				if !flagExportSource {
-					log.Println("skip typeparam", t)
-				}
 				foundGeneric = true
 				continue
 			}
 			e.Funcs = append(e.Funcs, fmt.Sprintf("%q : reflect.ValueOf(%v)", t.Name(), pkgName+"."+t.Name()))
+			if flagExportThunk {
+				if thunk, ok := exportThunk(pkg, pkgName, t); ok {
+					e.Thunks = append(e.Thunks, thunk)
+				}
+			}
 			e.usedPkg = true
 		case *types.TypeName:
 			if hasTypeParam(t.Type()) {
-				if !flagExportSource {
-					log.Println("skip typeparam", t)
-				}
 				foundGeneric = true
 				continue
 			}
@@ -415,10 +538,12 @@ func (p *Program) ExportPkg(path string, sname string) (*Package, error) {
 			log.Panicf("unreachable %v %T\n", name, t)
 		}
 	}
-	if flagExportSource && foundGeneric {
+	if (flagExportSource || p.hybrid) && foundGeneric {
 		if err := p.ExportSource(e, info); err != nil {
 			log.Println("export source failed", err)
 		}
+	} else if foundGeneric {
+		p.ExportGenerics(e, info)
 	}
 	return e, nil
 }
@@ -0,0 +1,173 @@
+//go:build go1.18
+// +build go1.18
+
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package export
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	_ "unsafe"
+
+	"github.com/goplus/igop"
+	"github.com/goplus/igop/testdata/hybrid"
+)
+
+//go:linkname hybridCount github.com/goplus/igop/testdata/hybrid.count
+var hybridCount int
+
+func TestExportHybrid(t *testing.T) {
+	path := "github.com/goplus/igop/testdata/hybrid"
+	p := NewProgram(nil)
+	p.hybrid = true
+	if err := p.Load([]string{path}); err != nil {
+		t.Fatal(err)
+	}
+	e, err := p.ExportPkg(path, "hybrid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := strings.Join(e.Links, ""); s != "//go:linkname _count "+path+".count\nvar _count int\n" {
+		t.Fatalf("unexported var must linkname and funcs not: %v", s)
+	}
+	if s := strings.Join(e.Vars, ","); s != `"Total" : reflect.ValueOf(&hybrid.Total),"count" : reflect.ValueOf(&_count)` {
+		t.Fatalf("bad vars %v", s)
+	}
+	if s := strings.Join(e.Funcs, ","); s != `"Add" : reflect.ValueOf(hybrid.Add)` {
+		t.Fatalf("bad funcs %v", s)
+	}
+	src, err := strconv.Unquote(e.Source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"func Add(n int)\n", "func scale(n int) int {", "var Total int\n", "var count int\n"} {
+		if !strings.Contains(src, s) {
+			t.Fatalf("not found %q in source:\n%v", s, src)
+		}
+	}
+	igop.RegisterPackage(&igop.Package{
+		Name:          "hybrid",
+		Path:          path,
+		Deps:          map[string]string{},
+		Interfaces:    map[string]reflect.Type{},
+		NamedTypes:    map[string]reflect.Type{},
+		AliasTypes:    map[string]reflect.Type{},
+		Vars:          map[string]reflect.Value{"Total": reflect.ValueOf(&hybrid.Total), "count": reflect.ValueOf(&hybridCount)},
+		Funcs:         map[string]reflect.Value{"Add": reflect.ValueOf(hybrid.Add)},
+		TypedConsts:   map[string]igop.TypedConst{},
+		UntypedConsts: map[string]igop.UntypedConst{},
+		Source:        src,
+	})
+	_, err = igop.RunFile("main.go", `package main
+
+import "github.com/goplus/igop/testdata/hybrid"
+
+type N int
+
+func main() {
+	n := hybrid.Count[N]()
+	hybrid.Sum[N](1, 2, 3)
+	if c := hybrid.Count[N](); c != n+3 {
+		panic(c)
+	}
+}
+`, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hybrid.Total != 60 {
+		t.Fatalf("native var not shared: %v", hybrid.Total)
+	}
+}
+
+func TestHybridLinkable(t *testing.T) {
+	pkg := types.NewPackage("example.com/p", "p")
+	named := func(name string) types.Type {
+		return types.NewNamed(types.NewTypeName(token.NoPos, pkg, name, nil), types.Typ[types.Int], nil)
+	}
+	for _, v := range []struct {
+		typ  types.Type
+		want bool
+	}{
+		{types.Typ[types.Int], true},
+		{types.NewMap(types.Typ[types.String], types.NewPointer(named("T"))), true},
+		{types.NewSlice(named("t")), false},
+		{types.Typ[types.UnsafePointer], false},
+		{types.NewStruct([]*types.Var{types.NewField(token.NoPos, pkg, "n", types.Typ[types.Int], false)}, nil), false},
+	} {
+		if linkable(pkg, v.typ) != v.want {
+			t.Fatalf("linkable %v, want %v", v.typ, v.want)
+		}
+	}
+}
+
+func TestExportThunk(t *testing.T) {
+	pkg := types.NewPackage("example.com/p", "p")
+	params := types.NewTuple(
+		types.NewVar(token.NoPos, pkg, "n", types.Typ[types.Int]),
+		types.NewVar(token.NoPos, pkg, "s", types.NewSlice(types.Typ[types.String])),
+	)
+	results := types.NewTuple(types.NewVar(token.NoPos, pkg, "", types.Typ[types.Int]))
+	fn := types.NewFunc(token.NoPos, pkg, "F", types.NewSignature(nil, params, results, true))
+	thunk, ok := exportThunk(pkg, "p", fn)
+	if !ok {
+		t.Fatal("exportThunk failed")
+	}
+	src := fmt.Sprintf("package p\nfunc F(n int, s ...string) int { return n + len(s) }\nvar thunk = %v\n", strings.TrimPrefix(thunk, `"F": `))
+	if _, err := parser.ParseFile(token.NewFileSet(), "thunk.go", src, 0); err != nil {
+		t.Fatalf("bad thunk %v: %v", thunk, err)
+	}
+	for _, s := range []string{"var a0 int\n", "if a[0] != nil {\na0 = a[0].(int)\n}\n", "a1 = a[1].([]string)\n", "r[0] = p.F(a0, a1...)"} {
+		if !strings.Contains(thunk, s) {
+			t.Fatalf("not found %q in thunk:\n%v", s, thunk)
+		}
+	}
+}
+
+func TestExportGenerics(t *testing.T) {
+	path := "github.com/goplus/igop/testdata/hybrid"
+	p := NewProgram(nil)
+	if err := p.Load([]string{path}); err != nil {
+		t.Fatal(err)
+	}
+	e, err := p.ExportPkg(path, "q")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Source != "" {
+		t.Fatalf("must not export source: %v", e.Source)
+	}
+	src, err := strconv.Unquote(e.GenericSource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"_q \"" + path + "\"", "func Sum[T ~int](v ...T) {", "_q.Add(scale(int(n)))", "func scale(n int) int {"} {
+		if !strings.Contains(src, s) {
+			t.Fatalf("not found %q in generic source:\n%v", s, src)
+		}
+	}
+	if strings.Contains(src, "func Add") || strings.Contains(src, "count") {
+		t.Fatalf("exported funcs must refer register package:\n%v", src)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package export
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+)
+
+// exportThunk return the typed call thunk of func, skip if the signature use
+// types can not be named in the export file.
+func exportThunk(pkg *types.Package, pkgName string, fn *types.Func) (string, bool) {
+	sig := fn.Type().(*types.Signature)
+	params, results := sig.Params(), sig.Results()
+	for _, tuple := range []*types.Tuple{params, results} {
+		for i := 0; i < tuple.Len(); i++ {
+			if !thunkType(pkg, tuple.At(i).Type()) {
+				return "", false
+			}
+		}
+	}
+	qualifier := func(*types.Package) string {
+		return pkgName
+	}
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "%q: func(a, r []interface{}) {\n", fn.Name())
+	args := make([]string, params.Len())
+	// nil is the zero value, other mismatched types panic
+	for i := range args {
+		fmt.Fprintf(&buf, "var a%v %v\n", i, types.TypeString(params.At(i).Type(), qualifier))
+		fmt.Fprintf(&buf, "if a[%v] != nil {\na%v = a[%v].(%v)\n}\n", i, i, i, types.TypeString(params.At(i).Type(), qualifier))
+		args[i] = fmt.Sprintf("a%v", i)
+	}
+	if sig.Variadic() {
+		args[len(args)-1] += "..."
+	}
+	call := fmt.Sprintf("%v.%v(%v)", pkgName, fn.Name(), strings.Join(args, ", "))
+	if n := results.Len(); n > 0 {
+		res := make([]string, n)
+		for i := range res {
+			res[i] = fmt.Sprintf("r[%v]", i)
+		}
+		call = strings.Join(res, ", ") + " = " + call
+	}
+	buf.WriteString(call)
+	buf.WriteString("\n}")
+	return buf.String(), true
+}
+
+// thunkType check the type can be named by the export package.
+func thunkType(pkg *types.Package, typ types.Type) bool {
+	switch t := typ.(type) {
+	case *types.Basic:
+		return t.Kind() != types.UnsafePointer && t.Info()&types.IsUntyped == 0
+	case *types.Named:
+		obj := t.Obj()
+		if obj.Pkg() == nil {
+			return true // error
+		}
+		return obj.Pkg() == pkg && obj.Exported() && !hasTypeParam(t)
+	case *types.Pointer:
+		return thunkType(pkg, t.Elem())
+	case *types.Slice:
+		return thunkType(pkg, t.Elem())
+	case *types.Array:
+		return thunkType(pkg, t.Elem())
+	case *types.Chan:
+		return thunkType(pkg, t.Elem())
+	case *types.Map:
+		return thunkType(pkg, t.Key()) && thunkType(pkg, t.Elem())
+	case *types.Signature:
+		for _, tuple := range []*types.Tuple{t.Params(), t.Results()} {
+			for i := 0; i < tuple.Len(); i++ {
+				if !thunkType(pkg, tuple.At(i).Type()) {
+					return false
+				}
+			}
+		}
+		return true
+	case *types.Interface:
+		return t.Empty()
+	}
+	return false
+}
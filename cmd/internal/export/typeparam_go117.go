@@ -28,6 +28,17 @@ func hasTypeParam(t types.Type) bool {
 	return false
 }
 
+// typeArgs is nil before go1.18.
+func typeArgs(t *types.Named) *typeList {
+	return nil
+}
+
+// typeList is types.TypeList before go1.18.
+type typeList struct{}
+
+func (l *typeList) Len() int            { return 0 }
+func (l *typeList) At(i int) types.Type { return nil }
+
 func funcHasTypeParams(fn *ast.FuncDecl) bool {
 	return false
 }
@@ -45,6 +45,11 @@ func hasTypeParam(t types.Type) bool {
 	return false
 }
 
+// typeArgs return the type arguments of instantiated named type.
+func typeArgs(t *types.Named) *types.TypeList {
+	return t.TypeArgs()
+}
+
 func recvHasTypeParam(expr ast.Expr) bool {
 retry:
 	switch v := expr.(type) {
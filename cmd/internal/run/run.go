@@ -18,6 +18,7 @@
 package run
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"log"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/goplus/igop"
 	"github.com/goplus/igop/cmd/internal/base"
+	"github.com/goplus/igop/cmd/internal/export"
 	"github.com/goplus/igop/cmd/internal/load"
 	"golang.org/x/tools/go/ssa"
 )
@@ -45,7 +47,7 @@ var (
 func init() {
 	Cmd.Run = runCmd
 	base.AddBuildFlags(Cmd, base.OmitModFlag|base.OmitSSAFlag|base.OmitSSATraceFlag|
-		base.OmitVFlag|base.OmitExperimentalGCFlag)
+		base.OmitVFlag|base.OmitExperimentalGCFlag|base.OmitAutoExportFlag|base.OmitDeadlockFlag|base.OmitRaceFlag|base.OmitScheduleFlag|base.OmitRecordFlag|base.OmitSuperInstrFlag|base.OmitOptFlag|base.OmitInlineFlag|base.OmitEagerFlag|base.OmitMaxDepthFlag)
 }
 
 func runCmd(cmd *base.Command, args []string) {
@@ -76,9 +78,54 @@ func runCmd(cmd *base.Command, args []string) {
 	if base.ExperimentalGC {
 		mode |= igop.ExperimentalSupportGC
 	}
+	if base.CheckDeadlock {
+		mode |= igop.CheckDeadlock
+	}
+	if base.CheckDataRace {
+		mode |= igop.CheckDataRace
+	}
+	if base.Schedule {
+		mode |= igop.DeterministicSchedule
+	}
+	if base.NoSuperInstr {
+		mode |= igop.DisableSuperInstr
+	}
+	if base.NoInline {
+		mode |= igop.DisableInline
+	}
+	if base.EagerCompile {
+		mode |= igop.EagerCompile
+	}
 	ctx := igop.NewContext(mode)
 	ctx.BuildContext = base.BuildContext
+	ctx.SetOptLevel(base.OptLevel)
+	ctx.SetScheduleSeed(base.ScheduleSeed)
+	if base.MaxDepth != 0 {
+		ctx.SetMaxCallDepth(base.MaxDepth)
+	}
 	ctx.RunContext = context.TODO()
+	if base.RecordFile != "" {
+		f, err := os.Create(base.RecordFile)
+		if err != nil {
+			log.Fatalln("create record file failed:", err)
+		}
+		defer f.Close()
+		ctx.SetRecord(f)
+	} else if base.ReplayFile != "" {
+		f, err := os.Open(base.ReplayFile)
+		if err != nil {
+			log.Fatalln("open replay file failed:", err)
+		}
+		defer f.Close()
+		if err := ctx.SetReplay(bufio.NewReader(f)); err != nil {
+			log.Fatalln(err)
+		}
+	}
+	if base.AutoExport {
+		auto := export.NewAutoImport()
+		auto.Verbose = base.BuildX
+		ctx.Missing = auto.Lookup
+	}
 	var pkg *ssa.Package
 	var input string
 	if isDir {
@@ -111,6 +158,8 @@ func runCmd(cmd *base.Command, args []string) {
 	if err != nil {
 		if e, ok := err.(igop.PanicError); ok {
 			fmt.Fprintf(os.Stderr, "panic: %v\n\n%s\n", e.Error(), e.Stack())
+		} else if e, ok := err.(igop.DeadlockError); ok {
+			fmt.Fprintf(os.Stderr, "%v\n\n%s\n", e.Error(), e.Stack())
 		} else {
 			fmt.Fprintln(os.Stderr, err)
 		}
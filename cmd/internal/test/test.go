@@ -26,6 +26,7 @@ import (
 
 	"github.com/goplus/igop"
 	"github.com/goplus/igop/cmd/internal/base"
+	"github.com/goplus/igop/cmd/internal/export"
 )
 
 // Cmd - igop test
@@ -37,7 +38,7 @@ var Cmd = &base.Command{
 func init() {
 	Cmd.Run = runCmd
 	base.AddBuildFlags(Cmd, base.OmitModFlag|base.OmitSSAFlag|base.OmitSSATraceFlag|
-		base.OmitVFlag|base.OmitExperimentalGCFlag)
+		base.OmitVFlag|base.OmitExperimentalGCFlag|base.OmitAutoExportFlag|base.OmitDeadlockFlag|base.OmitRaceFlag|base.OmitScheduleFlag|base.OmitLeakCheckFlag|base.OmitSuperInstrFlag|base.OmitOptFlag|base.OmitInlineFlag|base.OmitEagerFlag|base.OmitMaxDepthFlag)
 }
 
 func runCmd(cmd *base.Command, args []string) {
@@ -103,8 +104,39 @@ func runCmd(cmd *base.Command, args []string) {
 	if base.ExperimentalGC {
 		mode |= igop.ExperimentalSupportGC
 	}
+	if base.CheckDeadlock {
+		mode |= igop.CheckDeadlock
+	}
+	if base.CheckDataRace {
+		mode |= igop.CheckDataRace
+	}
+	if base.Schedule {
+		mode |= igop.DeterministicSchedule
+	}
+	if base.NoSuperInstr {
+		mode |= igop.DisableSuperInstr
+	}
+	if base.NoInline {
+		mode |= igop.DisableInline
+	}
+	if base.EagerCompile {
+		mode |= igop.EagerCompile
+	}
+	if base.CheckLeak {
+		mode |= igop.CheckGoroutineLeak
+	}
 	ctx := igop.NewContext(mode)
 	ctx.BuildContext = base.BuildContext
+	ctx.SetOptLevel(base.OptLevel)
+	ctx.SetScheduleSeed(base.ScheduleSeed)
+	if base.MaxDepth != 0 {
+		ctx.SetMaxCallDepth(base.MaxDepth)
+	}
+	if base.AutoExport {
+		auto := export.NewAutoImport()
+		auto.Verbose = base.BuildX
+		ctx.Missing = auto.Lookup
+	}
 
 	pkg, err := ctx.LoadDir(path, true)
 	if err != nil {
@@ -56,6 +56,13 @@ const (
 	ExperimentalSupportGC                  // experimental support runtime.GC
 	SupportMultipleInterp                  // Support multiple interp, must manual release interp reflectx icall.
 	CheckGopOverloadFunc                   // Check and skip gop overload func
+	CheckDeadlock                          // Check all goroutines are asleep on channel operations and report deadlock
+	CheckDataRace                          // Check data race of goroutines and report like go race detector
+	CheckGoroutineLeak                     // Check goroutines still alive after main or each test returns and report leak
+	DeterministicSchedule                  // Schedule goroutines cooperatively by seed for reproducible runs
+	DisableSuperInstr                      // Disable fused superinstructions of common SSA patterns for debugging
+	DisableInline                          // Disable inline small leaf functions into the instrs of caller
+	EagerCompile                           // Compile all functions when create interp instead of on the first call
 )
 
 // Loader types loader interface
@@ -77,14 +84,23 @@ type Context struct {
 	FileSet      *token.FileSet                                           // file set
 	sizes        types.Sizes                                              // types unsafe sizes
 	Lookup       func(root, path string) (dir string, found bool)         // lookup external import
+	Missing      func(root, path string) (dir string, found bool)         // lookup missing import, dir is empty if register package
 	evalCallFn   func(interp *Interp, call *ssa.Call, res ...interface{}) // internal eval func for repl
 	debugFunc    func(*DebugInfo)                                         // debug func
 	pkgs         map[string]*sourcePackage                                // imports
 	override     map[string]reflect.Value                                 // override function
+	mocks        map[string]interface{}                                   // mock function or method
+	suspends     map[string]bool                                          // functions suspend goroutine
 	evalInit     map[string]bool                                          // eval init check
 	nestedMap    map[*types.Named]int                                     // nested named index
 	root         string                                                   // project root
 	callForPool  int                                                      // least call count for enable function pool
+	schedSeed    int64                                                    // seed of deterministic schedule
+	schedQuantum int                                                      // instructions to preempt of deterministic schedule
+	clock        *VirtualClock                                            // virtual clock of time, nil if real time
+	record       *recorder                                                // record or replay external calls, nil if disabled
+	optLevel     int                                                      // level of SSA optimization
+	maxDepth     int                                                      // max depth of call stack, no limit if 0
 	Mode         Mode                                                     // mode
 	BuilderMode  ssa.BuilderMode                                          // ssa builder mode
 	evalMode     bool                                                     // eval mode
@@ -124,6 +140,12 @@ func (sp *sourcePackage) Load() (err error) {
 			Sizes:    sp.Context.sizes,
 			Importer: NewImporter(sp.Context),
 		}
+		// the go directive of go.mod, loop vars per-iteration since go1.22
+		var version string
+		if sp.Dir != "" {
+			version = load.GetGoVersion(sp.Dir)
+		}
+		setGoVersion(conf, version)
 		if sp.Context.evalMode {
 			conf.DisableUnusedImportCheck = true
 		}
@@ -163,8 +185,10 @@ func NewContext(mode Mode) *Context {
 		BuildContext: build.Default,
 		pkgs:         make(map[string]*sourcePackage),
 		override:     make(map[string]reflect.Value),
+		mocks:        make(map[string]interface{}),
 		nestedMap:    make(map[*types.Named]int),
 		callForPool:  64,
+		maxDepth:     500000,
 	}
 	ctx.Loader = NewTypesLoader(ctx, mode)
 	if mode&EnableDumpInstr != 0 {
@@ -173,6 +197,12 @@ func NewContext(mode Mode) *Context {
 	if mode&ExperimentalSupportGC != 0 {
 		ctx.RegisterExternal("runtime.GC", runtimeGC)
 	}
+	if mode&CheckDataRace != 0 {
+		registerRaceExternals(ctx)
+	}
+	if mode&DeterministicSchedule != 0 {
+		registerScheduleExternals(ctx)
+	}
 	ctx.sizes = types.SizesFor("gc", runtime.GOARCH)
 	ctx.Lookup = new(load.ListDriver).Lookup
 
@@ -203,6 +233,44 @@ func (ctx *Context) SetLeastCallForEnablePool(count int) {
 	ctx.callForPool = count
 }
 
+// SetScheduleSeed set the seed of DeterministicSchedule mode, default 0
+func (ctx *Context) SetScheduleSeed(seed int64) {
+	ctx.schedSeed = seed
+}
+
+// SetScheduleQuantum set the instructions run before preempt goroutine of
+// DeterministicSchedule mode, default 1000
+func (ctx *Context) SetScheduleQuantum(n int) {
+	ctx.schedQuantum = n
+}
+
+// SetOptLevel set the level of SSA optimization before interpretation, 0
+// disable it. Level 1 fold consts, simplify phis, remove dead instrs and
+// unreachable blocks, level 2 also elide bounds checks in counted loops.
+// The interps created before keep the level.
+func (ctx *Context) SetOptLevel(level int) {
+	ctx.optLevel = level
+}
+
+// SetMaxCallDepth set the max depth of interpreted call stack, the call
+// exceed it panic stack overflow that can not recover. No limit if depth
+// <= 0, default 500000.
+func (ctx *Context) SetMaxCallDepth(depth int) {
+	ctx.maxDepth = depth
+}
+
+// config return the settings of interp created.
+func (ctx *Context) config() interpConfig {
+	return interpConfig{
+		mode:        ctx.Mode,
+		optLevel:    ctx.optLevel,
+		callForPool: ctx.callForPool,
+		maxDepth:    ctx.maxDepth,
+		evalMode:    ctx.evalMode,
+		debugFunc:   ctx.debugFunc,
+	}
+}
+
 func (ctx *Context) SetDebug(fn func(*DebugInfo)) {
 	ctx.BuilderMode |= ssa.GlobalDebug
 	ctx.debugFunc = fn
@@ -551,6 +619,7 @@ func (p *Context) runInterpWithContext(interp *Interp, input string, args []stri
 			exitCode = 2
 			err = fmt.Errorf("interrupt timeout: all goroutines are asleep - deadlock!")
 		case <-ch:
+			exitCode = 2
 			err = ctx.Err()
 		}
 	case err = <-ch:
@@ -617,7 +686,13 @@ func (ctx *Context) TestPkg(pkg *ssa.Package, input string, args []string) error
 		failed = true
 		fmt.Printf("init error: %v\n", err)
 	}
-	exitCode, _ := interp.RunMain()
+	exitCode, err := interp.RunMain()
+	switch e := err.(type) {
+	case DeadlockError:
+		fmt.Printf("%v\n\n%s\n", e.Error(), e.Stack())
+	case GoroutineLeakError:
+		fmt.Printf("%v\n\n%s\n", e.Error(), e.Stack())
+	}
 	if exitCode != 0 {
 		failed = true
 	}
@@ -716,6 +791,9 @@ func (ctx *Context) buildPackage(sp *sourcePackage) (pkg *ssa.Package, err error
 						}
 					}
 					prog.CreatePackage(p, nil, nil, true).Build()
+					if gp, ok := ctx.pkgs[p.Path()+"@generic"]; ok {
+						createAll([]*types.Package{gp.Package})
+					}
 				}
 			}
 		}
@@ -753,7 +831,10 @@ func (ctx *Context) checkNested(pkg *types.Package, info *types.Info) {
 		switch k.(type) {
 		case *ast.BlockStmt, *ast.FuncType:
 			for _, name := range v.Names() {
-				obj := v.Lookup(name)
+				obj, ok := v.Lookup(name).(*types.TypeName)
+				if !ok || obj.IsAlias() {
+					continue
+				}
 				if named, ok := obj.Type().(*types.Named); ok && named.Obj().Pkg() == pkg {
 					nestedList = append(nestedList, named)
 				}
@@ -0,0 +1,1102 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"go/types"
+	"hash/fnv"
+	"math"
+	"reflect"
+	"sort"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/goplus/reflectx"
+	"github.com/visualfc/funcval"
+	"golang.org/x/tools/go/ssa"
+)
+
+// RegisterSuspend register the function suspend the goroutine calling it,
+// the key is the function name like "workflow.Await". The static call of
+// function in RunFunc return *Continuation as error, the call return the
+// results passed by Resume.
+func (ctx *Context) RegisterSuspend(key string) {
+	if ctx.suspends == nil {
+		ctx.suspends = make(map[string]bool)
+	}
+	ctx.suspends[key] = true
+}
+
+// Continuation is the goroutine suspended by the function registered by
+// RegisterSuspend. The frames of goroutine from the entry of RunFunc must
+// have no defer and recover, they are on heap and resumed by Resume or
+// marshaled to bytes and resumed by the interp of same program in other
+// process.
+type Continuation struct {
+	interp  *Interp
+	frames  []*frame // frames from entry to the caller of suspend function
+	name    string   // name of suspend function
+	args    []value  // args of suspend call
+	resumed int32    // resume once
+}
+
+func (c *Continuation) Error() string {
+	return "goroutine suspended at " + c.name
+}
+
+// Func return the name of suspend function.
+func (c *Continuation) Func() string {
+	return c.name
+}
+
+// Args return the args of suspend call.
+func (c *Continuation) Args() []Value {
+	return c.args
+}
+
+// makeSuspendInstr make the call of suspend function, it panic the
+// continuation recovered by the entry of goroutine.
+func makeSuspendInstr(fn *ssa.Function, ia []register) func(fr *frame) {
+	name := fn.String()
+	return func(fr *frame) {
+		if fr.interp.ctx.evalCallFn != nil {
+			panic(PanicError{stack: debugStack(fr), Value: ErrNotSuspendable})
+		}
+		for f := fr; f != nil && f.pfn != nil; f = f.caller {
+			if f.pfn.hasRecover() || f._defer != nil {
+				panic(PanicError{stack: debugStack(fr), Value: ErrNotSuspendable})
+			}
+		}
+		args := make([]value, len(ia))
+		for i, r := range ia {
+			args[i] = fr.reg(r)
+		}
+		panic(&Continuation{interp: fr.interp, frames: []*frame{fr}, name: name, args: args})
+	}
+}
+
+// suspended check the continuation panic from callee of entry frame, and
+// make the frames from entry.
+func (c *Continuation) suspended(entry *frame) error {
+	fr := c.frames[0]
+	var frames []*frame
+	for ; fr != nil && fr.pfn != nil; fr = fr.caller {
+		frames = append(frames, fr)
+	}
+	if fr != entry {
+		return ErrNotSuspendable // suspend in native callback
+	}
+	for i, n := 0, len(frames); i < n/2; i++ {
+		frames[i], frames[n-1-i] = frames[n-1-i], frames[i]
+	}
+	c.frames = frames
+	return c
+}
+
+// suspendCall return the call of suspend function and the register of
+// results in frame.
+func (c *Continuation) suspendCall() (*ssa.Call, register) {
+	fr := c.frames[len(c.frames)-1]
+	call, ok := fr.pfn.InstrForPC(fr.ipc - 1).(*ssa.Call)
+	if !ok {
+		panic(fmt.Errorf("bad suspend call of %v", fr.pfn.Fn))
+	}
+	return call, fr.pfn.regIndex(call)
+}
+
+// Resume run the goroutine suspended by c, the results is returned by the
+// call of suspend function. It return like RunFunc and may be suspend again.
+func (i *Interp) Resume(c *Continuation, results ...Value) (Value, error) {
+	if c.interp != i {
+		return nil, fmt.Errorf("continuation of other interp")
+	}
+	if !atomic.CompareAndSwapInt32(&c.resumed, 0, 1) {
+		return nil, ErrResumed
+	}
+	call, ir := c.suspendCall()
+	rtyp := call.Call.Signature().Results()
+	if rtyp.Len() != len(results) {
+		return nil, fmt.Errorf("resume %v want %v results, got %v", c.name, rtyp.Len(), len(results))
+	}
+	res := make([]value, len(results))
+	for n, v := range results {
+		if v == nil {
+			if typ := i.toType(rtyp.At(n).Type()); typ.Kind() != reflect.Interface {
+				v = reflect.Zero(typ).Interface()
+			}
+		}
+		res[n] = v
+	}
+	root, leaf := c.frames[0], c.frames[len(c.frames)-1]
+	switch len(res) {
+	case 0:
+	case 1:
+		leaf.setReg(ir, res[0])
+	default:
+		leaf.setReg(ir, tuple(res))
+	}
+	return i.runFrame(func(fr *frame) (result value) {
+		root.caller = fr
+		fr.callee = root
+		root.loop(leaf)
+		pfn := root.pfn
+		if pfn.nres == 1 {
+			result = root.stack[0]
+		} else if pfn.nres > 1 {
+			result = tuple(root.stack[0:pfn.nres])
+		}
+		pfn.deleteFrame(fr, root)
+		return
+	})
+}
+
+// The format of marshaled continuation.
+const contMagic = "igopcont2"
+
+// Tags of value in marshaled continuation.
+const (
+	tagNil = iota
+	tagNew
+	tagRef
+	tagGlobal
+)
+
+// Tags of type in marshaled continuation.
+const (
+	typeNil = iota
+	typeBasic
+	typeNamed
+	typeError
+	typeTuple
+	typeEmptyInterface
+	typePtr
+	typeSlice
+	typeArray
+	typeMap
+	typeFunc
+	typeStruct
+)
+
+var (
+	typeOfTuple          = reflect.TypeOf(tuple{})
+	typeOfError          = reflect.TypeOf((*error)(nil)).Elem()
+	typeOfEmptyInterface = reflect.TypeOf((*interface{})(nil)).Elem()
+	basicTypes           = make(map[reflect.Kind]reflect.Type)
+)
+
+func init() {
+	for _, v := range []interface{}{false, int(0), int8(0), int16(0), int32(0), int64(0),
+		uint(0), uint8(0), uint16(0), uint32(0), uint64(0), uintptr(0),
+		float32(0), float64(0), complex64(0), complex128(0), ""} {
+		t := reflect.TypeOf(v)
+		basicTypes[t.Kind()] = t
+	}
+}
+
+// contError is the error of marshal or unmarshal.
+type contError struct {
+	err error
+}
+
+// catchContError set the error of marshal or unmarshal, the panic of
+// reflect by bad data is error too.
+func catchContError(err *error) {
+	switch e := recover().(type) {
+	case nil:
+	case contError:
+		*err = e.err
+	default:
+		*err = fmt.Errorf("bad continuation data: %v", e)
+	}
+}
+
+func contErrorf(format string, args ...interface{}) {
+	panic(contError{fmt.Errorf(format, args...)})
+}
+
+type ptrKey struct {
+	p uintptr
+	t reflect.Type
+}
+
+// contObject is the memory of variable.
+type contObject struct {
+	base       unsafe.Pointer // pointer to memory
+	start, end uintptr        // address of memory
+	typ        reflect.Type   // type of memory
+	name       string         // name of global
+}
+
+// contEncoder write the frames and the reachable values. The memory of
+// pointers and slices are collected first, the memory overlapped is one
+// variable and the pointers and slices refer to the offset of variable.
+// The variables are written once and the globals are written by name.
+type contEncoder struct {
+	interp  *Interp
+	buf     bytes.Buffer
+	collect bool            // collect memory, the data written is dropped
+	seen    map[ptrKey]bool // memory collected
+	objects []contObject    // memory collected
+	globals []contObject    // sorted memory of globals
+	vars    []contObject    // sorted variables written
+	maps    map[uintptr]int // maps written
+}
+
+// MarshalBinary marshal the continuation not resumed. The values reachable
+// from registers are written, the globals are not written and the pointer
+// to global refer to the global of interp resumed. The channels, unsafe
+// pointers, native functions and local or generic named types are not
+// supported.
+func (c *Continuation) MarshalBinary() (data []byte, err error) {
+	if atomic.LoadInt32(&c.resumed) != 0 {
+		return nil, ErrResumed
+	}
+	defer catchContError(&err)
+	e := &contEncoder{interp: c.interp, collect: true, seen: make(map[ptrKey]bool), maps: make(map[uintptr]int)}
+	for name, v := range c.interp.globals {
+		p := reflect.ValueOf(v)
+		if size := p.Type().Elem().Size(); size != 0 {
+			e.globals = append(e.globals, contObject{unsafe.Pointer(p.Pointer()), p.Pointer(), p.Pointer() + size, p.Type().Elem(), name})
+		}
+	}
+	sort.Slice(e.globals, func(i, j int) bool {
+		return e.globals[i].start < e.globals[j].start
+	})
+	e.continuation(c)
+	e.layout()
+	e.collect = false
+	e.maps = make(map[uintptr]int)
+	e.buf.Reset()
+	e.buf.WriteString(contMagic)
+	e.uint(uint64(len(e.vars)))
+	for _, v := range e.vars {
+		if v.name != "" {
+			e.uint(tagGlobal)
+			e.string(v.name)
+		} else {
+			e.uint(tagNew)
+			e.typ(v.typ)
+		}
+	}
+	for _, v := range e.vars {
+		if v.name == "" {
+			e.value(reflect.NewAt(v.typ, v.base).Elem())
+		}
+	}
+	e.continuation(c)
+	return e.buf.Bytes(), nil
+}
+
+func (e *contEncoder) continuation(c *Continuation) {
+	e.string(c.name)
+	e.uint(uint64(len(c.args)))
+	for _, v := range c.args {
+		e.iface(v)
+	}
+	e.uint(uint64(len(c.frames)))
+	for _, fr := range c.frames {
+		e.frame(fr)
+	}
+}
+
+// lookup return the index of object contains p.
+func lookup(objs []contObject, p uintptr) int {
+	n := sort.Search(len(objs), func(i int) bool {
+		return objs[i].end > p
+	})
+	if n < len(objs) && objs[n].start <= p {
+		return n
+	}
+	return -1
+}
+
+// object write the reference to memory at p of type t, it return true if
+// the values of memory need collect.
+func (e *contEncoder) object(base unsafe.Pointer, t reflect.Type) bool {
+	p := uintptr(base)
+	if t.Size() == 0 {
+		e.uint(tagNew)
+		return false
+	}
+	if !e.collect {
+		n := lookup(e.vars, p)
+		e.uint(tagRef)
+		e.uint(uint64(n))
+		e.uint(uint64(p - e.vars[n].start))
+		return false
+	}
+	key := ptrKey{p, t}
+	if e.seen[key] {
+		return false
+	}
+	e.seen[key] = true
+	if n := lookup(e.globals, p); n >= 0 {
+		if p+t.Size() > e.globals[n].end {
+			contErrorf("pointer to part of variable not supported")
+		}
+		e.objects = append(e.objects, e.globals[n])
+		return false
+	}
+	e.objects = append(e.objects, contObject{base: base, start: p, end: p + t.Size(), typ: t})
+	return true
+}
+
+// layout make the variables of memory collected, the slices of same array
+// overlapped is merged.
+func (e *contEncoder) layout() {
+	objs := e.objects
+	sort.Slice(objs, func(i, j int) bool {
+		if objs[i].start != objs[j].start {
+			return objs[i].start < objs[j].start
+		}
+		return objs[i].end > objs[j].end
+	})
+	for _, o := range objs {
+		n := len(e.vars) - 1
+		if n < 0 || o.start >= e.vars[n].end {
+			e.vars = append(e.vars, o)
+			continue
+		}
+		v := &e.vars[n]
+		if o.end <= v.end && o.name == v.name {
+			continue
+		}
+		elem := elemOf(v.typ)
+		if o.end <= v.end || v.name != "" || o.name != "" || elem != elemOf(o.typ) || (o.start-v.start)%elem.Size() != 0 {
+			contErrorf("pointer to part of variable not supported")
+		}
+		v.end = o.end
+		v.typ = reflect.ArrayOf(int((v.end-v.start)/elem.Size()), elem)
+	}
+}
+
+// elemOf return the element type of array, or t for other.
+func elemOf(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Array {
+		return t.Elem()
+	}
+	return t
+}
+
+func (e *contEncoder) frame(fr *frame) {
+	pfn := fr.pfn
+	e.string(pfn.Fn.String())
+	e.uint(pfn.layout())
+	block := -1
+	if fr.block != nil {
+		block = fr.block.Index
+	}
+	e.int(int64(block))
+	e.int(int64(fr.ipc))
+	e.int(int64(fr.pred))
+	e.uint(uint64(fr.ir))
+	// the registers of consts, globals and functions are set by allocFrame
+	regs := pfn.dynamicRegs()
+	e.uint(uint64(len(regs)))
+	for _, r := range regs {
+		e.uint(uint64(r))
+		e.iface(fr.stack[r])
+	}
+	e.uint(uint64(len(fr.ints)))
+	for _, v := range fr.ints {
+		e.uint(v)
+	}
+	e.uint(uint64(len(fr.floats)))
+	for _, v := range fr.floats {
+		e.uint(math.Float64bits(v))
+	}
+}
+
+// layout return the hash of compiled instrs and registers, the frame is
+// resumed by the function of same layout.
+func (p *function) layout() uint64 {
+	h := fnv.New64a()
+	fmt.Fprintln(h, len(p.stack), p.nints, p.nfloats, p.Blocks)
+	for _, instr := range p.ssaInstrs {
+		fmt.Fprintf(h, "%T %v", instr, instr)
+		if v, ok := instr.(ssa.Value); ok {
+			fmt.Fprint(h, " ", p.index[p.value(v)], " ", p.unboxed[v])
+		}
+		fmt.Fprintln(h)
+	}
+	return h.Sum64()
+}
+
+// dynamicRegs return the registers of frame set by instrs.
+func (p *function) dynamicRegs() (regs []register) {
+	static := make(map[register]bool)
+	for v, index := range p.index {
+		switch v.(type) {
+		case *ssa.Const, *ssa.Global, *ssa.Function:
+			static[register(index&0xffffff)] = true
+		}
+	}
+	for r := range p.stack {
+		if !static[register(r)] {
+			regs = append(regs, register(r))
+		}
+	}
+	return
+}
+
+func (e *contEncoder) uint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	e.buf.Write(b[:binary.PutUvarint(b[:], v)])
+}
+
+func (e *contEncoder) int(v int64) {
+	var b [binary.MaxVarintLen64]byte
+	e.buf.Write(b[:binary.PutVarint(b[:], v)])
+}
+
+func (e *contEncoder) string(s string) {
+	e.uint(uint64(len(s)))
+	e.buf.WriteString(s)
+}
+
+func (e *contEncoder) iface(v value) {
+	if v == nil {
+		e.typ(nil)
+		return
+	}
+	rv := reflect.ValueOf(v)
+	e.typ(rv.Type())
+	e.value(rv)
+}
+
+func (e *contEncoder) typ(t reflect.Type) {
+	switch {
+	case t == nil:
+		e.uint(typeNil)
+	case t == typeOfTuple:
+		e.uint(typeTuple)
+	case t == typeOfError:
+		e.uint(typeError)
+	case t == typeOfEmptyInterface:
+		e.uint(typeEmptyInterface)
+	case t.Name() != "":
+		if basicTypes[t.Kind()] == t {
+			e.uint(typeBasic)
+			e.uint(uint64(t.Kind()))
+			return
+		}
+		if rt, ok := e.interp.namedType(t.PkgPath(), t.Name()); !ok || rt != t {
+			contErrorf("type %v not supported", t)
+		}
+		e.uint(typeNamed)
+		e.string(t.PkgPath())
+		e.string(t.Name())
+	default:
+		switch t.Kind() {
+		case reflect.Ptr:
+			e.uint(typePtr)
+			e.typ(t.Elem())
+		case reflect.Slice:
+			e.uint(typeSlice)
+			e.typ(t.Elem())
+		case reflect.Array:
+			e.uint(typeArray)
+			e.uint(uint64(t.Len()))
+			e.typ(t.Elem())
+		case reflect.Map:
+			e.uint(typeMap)
+			e.typ(t.Key())
+			e.typ(t.Elem())
+		case reflect.Func:
+			e.uint(typeFunc)
+			e.uint(uint64(t.NumIn()))
+			for i := 0; i < t.NumIn(); i++ {
+				e.typ(t.In(i))
+			}
+			e.uint(uint64(t.NumOut()))
+			for i := 0; i < t.NumOut(); i++ {
+				e.typ(t.Out(i))
+			}
+			if t.IsVariadic() {
+				e.uint(1)
+			} else {
+				e.uint(0)
+			}
+		case reflect.Struct:
+			e.uint(typeStruct)
+			e.uint(uint64(t.NumField()))
+			for i := 0; i < t.NumField(); i++ {
+				f := t.Field(i)
+				e.string(f.Name)
+				e.string(f.PkgPath)
+				e.string(string(f.Tag))
+				if f.Anonymous {
+					e.uint(1)
+				} else {
+					e.uint(0)
+				}
+				e.typ(f.Type)
+			}
+		default:
+			contErrorf("type %v not supported", t)
+		}
+	}
+}
+
+func (e *contEncoder) value(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			e.uint(1)
+		} else {
+			e.uint(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.int(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		e.uint(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		e.uint(math.Float64bits(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		e.uint(math.Float64bits(real(c)))
+		e.uint(math.Float64bits(imag(c)))
+	case reflect.String:
+		e.string(v.String())
+	case reflect.Interface:
+		if v.IsNil() {
+			e.typ(nil)
+			return
+		}
+		e.typ(v.Elem().Type())
+		e.value(v.Elem())
+	case reflect.Ptr:
+		if v.IsNil() {
+			e.uint(tagNil)
+			return
+		}
+		if e.object(unsafe.Pointer(v.Pointer()), v.Type().Elem()) {
+			e.value(v.Elem())
+		}
+	case reflect.Slice:
+		if v.IsNil() {
+			e.uint(tagNil)
+			return
+		}
+		if e.object(unsafe.Pointer(v.Pointer()), reflect.ArrayOf(v.Cap(), v.Type().Elem())) {
+			a := v.Slice(0, v.Cap())
+			for i := 0; i < a.Len(); i++ {
+				e.value(a.Index(i))
+			}
+		}
+		e.uint(uint64(v.Len()))
+		e.uint(uint64(v.Cap()))
+	case reflect.Array:
+		if v.Type().Size() == 0 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			e.value(v.Index(i))
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			e.uint(tagNil)
+			return
+		}
+		if id, ok := e.maps[v.Pointer()]; ok {
+			e.uint(tagRef)
+			e.uint(uint64(id))
+			return
+		}
+		e.maps[v.Pointer()] = len(e.maps)
+		e.uint(tagNew)
+		e.uint(uint64(v.Len()))
+		iter := v.MapRange()
+		for iter.Next() {
+			e.value(iter.Key())
+			e.value(iter.Value())
+		}
+	case reflect.Struct:
+		if !v.CanAddr() {
+			c := reflect.New(v.Type()).Elem()
+			c.Set(v)
+			v = c
+		}
+		for i := 0; i < v.NumField(); i++ {
+			e.value(fieldOf(v, i))
+		}
+	case reflect.Func:
+		if v.IsNil() {
+			e.uint(tagNil)
+			return
+		}
+		fv, n := funcval.Get(v.Interface())
+		if n != 1 {
+			contErrorf("native func %v not supported", v.Type())
+		}
+		c := (*makeFuncVal)(unsafe.Pointer(fv))
+		e.uint(tagNew)
+		e.string(c.pfn.Fn.String())
+		e.uint(uint64(len(c.env)))
+		for _, v := range c.env {
+			e.iface(v)
+		}
+	default:
+		contErrorf("value of %v not supported", v.Type())
+	}
+}
+
+// fieldOf return the settable field of addressable struct.
+func fieldOf(v reflect.Value, i int) reflect.Value {
+	f := v.Field(i)
+	if f.CanSet() {
+		return f
+	}
+	return reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
+}
+
+// namedType lookup the named type of package by name.
+func (i *Interp) namedType(path, name string) (reflect.Type, bool) {
+	pkg := i.mainpkg
+	if pkg.Pkg.Path() != path {
+		pkg = pkg.Prog.ImportedPackage(path)
+	}
+	if pkg != nil {
+		if obj, ok := pkg.Pkg.Scope().Lookup(name).(*types.TypeName); ok {
+			if _, ok := obj.Type().(*types.Named); ok {
+				return i.toType(obj.Type()), true
+			}
+		}
+	}
+	if pkg, ok := LookupPackage(path); ok {
+		if t, ok := pkg.NamedTypes[name]; ok {
+			return t, true
+		}
+		if t, ok := pkg.Interfaces[name]; ok {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+// contDecoder read the continuation written by contEncoder.
+type contDecoder struct {
+	interp *Interp
+	data   []byte
+	vars   []reflect.Value // pointers to variables
+	maps   []reflect.Value
+	funcs  map[string]*function
+}
+
+// UnmarshalContinuation unmarshal the continuation marshaled by the interp
+// of same program, the continuation is resumed by i. The frame of function
+// compiled with other layout, by other source or optimization level, is
+// error.
+func (i *Interp) UnmarshalContinuation(data []byte) (c *Continuation, err error) {
+	if !bytes.HasPrefix(data, []byte(contMagic)) {
+		return nil, errors.New("bad continuation data")
+	}
+	defer catchContError(&err)
+	d := &contDecoder{interp: i, data: data[len(contMagic):], funcs: make(map[string]*function)}
+	for fn, pfn := range i.funcs {
+		d.funcs[fn.String()] = pfn
+	}
+	d.vars = make([]reflect.Value, d.len())
+	news := make([]bool, len(d.vars))
+	for n := range d.vars {
+		switch d.uint() {
+		case tagGlobal:
+			name := d.string()
+			g, ok := i.globals[name]
+			if !ok {
+				contErrorf("not found global %v", name)
+			}
+			d.vars[n] = reflect.ValueOf(g)
+		case tagNew:
+			t := d.typ()
+			if t == nil {
+				contErrorf("bad continuation data")
+			}
+			d.size(1, t)
+			d.vars[n] = reflect.New(t)
+			news[n] = true
+		default:
+			contErrorf("bad continuation data")
+		}
+	}
+	for n, v := range d.vars {
+		if news[n] {
+			d.value(v.Elem())
+		}
+	}
+	c = &Continuation{interp: i, name: d.string()}
+	c.args = make([]value, d.len())
+	for n := range c.args {
+		c.args[n] = d.iface()
+	}
+	caller := &frame{interp: i}
+	for n := d.len(); n > 0; n-- {
+		fr := d.frame(caller)
+		c.frames = append(c.frames, fr)
+		caller = fr
+	}
+	if len(c.frames) == 0 {
+		return nil, errors.New("bad continuation data")
+	}
+	if call, _ := c.suspendCall(); call.Call.StaticCallee() == nil || call.Call.StaticCallee().String() != c.name {
+		return nil, fmt.Errorf("bad suspend call %v", c.name)
+	}
+	return c, nil
+}
+
+func (d *contDecoder) frame(caller *frame) *frame {
+	name := d.string()
+	pfn, ok := d.funcs[name]
+	if !ok {
+		contErrorf("not found function %v", name)
+	}
+	fr := pfn.allocFrame(caller)
+	pfn = fr.pfn
+	if d.uint() != pfn.layout() {
+		contErrorf("function %v compiled with other layout", name)
+	}
+	if block := int(d.int()); block >= 0 && block < len(pfn.Fn.Blocks) {
+		fr.block = pfn.Fn.Blocks[block]
+	}
+	fr.ipc = int(d.int())
+	fr.pred = int(d.int())
+	fr.ir = register(d.uint())
+	if fr.ipc <= 0 || fr.ipc > len(pfn.Instrs) {
+		contErrorf("bad pc of function %v", name)
+	}
+	for n := d.len(); n > 0; n-- {
+		r := d.uint()
+		if r >= uint64(len(fr.stack)) {
+			contErrorf("bad register of function %v", name)
+		}
+		fr.stack[r] = d.iface()
+	}
+	if n := d.uint(); n != uint64(len(fr.ints)) {
+		contErrorf("bad registers of function %v", name)
+	}
+	for n := range fr.ints {
+		fr.ints[n] = d.uint()
+	}
+	if n := d.uint(); n != uint64(len(fr.floats)) {
+		contErrorf("bad registers of function %v", name)
+	}
+	for n := range fr.floats {
+		fr.floats[n] = math.Float64frombits(d.uint())
+	}
+	return fr
+}
+
+func (d *contDecoder) uint() uint64 {
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		contErrorf("bad continuation data")
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *contDecoder) int() int64 {
+	v, n := binary.Varint(d.data)
+	if n <= 0 {
+		contErrorf("bad continuation data")
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+// ref read the reference to variable, p is the pointer to variable and off
+// is the offset for tagRef.
+func (d *contDecoder) ref() (tag uint64, p reflect.Value, off uintptr) {
+	switch tag = d.uint(); tag {
+	case tagNil, tagNew:
+	case tagRef:
+		id, n := d.uint(), d.uint()
+		if id >= uint64(len(d.vars)) || n >= uint64(d.vars[id].Type().Elem().Size()) {
+			contErrorf("bad continuation data")
+		}
+		p, off = d.vars[id], uintptr(n)
+	default:
+		contErrorf("bad continuation data")
+	}
+	return
+}
+
+// typeAt check the memory of type t at offset off is the value of type
+// want, the array of same elem at offset is valid.
+func typeAt(t reflect.Type, off uintptr, want reflect.Type) bool {
+	for {
+		if off == 0 && t == want {
+			return true
+		}
+		if off > t.Size() || want.Size() > t.Size()-off {
+			return false
+		}
+		switch t.Kind() {
+		case reflect.Struct:
+			i := t.NumField() - 1
+			for i >= 0 && (t.Field(i).Offset > off || t.Field(i).Type.Size() == 0) {
+				i--
+			}
+			if i < 0 {
+				return false
+			}
+			f := t.Field(i)
+			t, off = f.Type, off-f.Offset
+		case reflect.Array:
+			elem := t.Elem()
+			if elem.Size() == 0 {
+				return false
+			}
+			if want.Kind() == reflect.Array && want.Elem() == elem && off%elem.Size() == 0 {
+				return true
+			}
+			t, off = elem, off%elem.Size()
+		default:
+			return false
+		}
+	}
+}
+
+// len read the count of values, each value is written at least one byte.
+func (d *contDecoder) len() int {
+	n := d.uint()
+	if n > uint64(len(d.data)) {
+		contErrorf("bad continuation data")
+	}
+	return int(n)
+}
+
+// size check the memory of n values of type t for data, a byte of data
+// is decoded to the value of 32 bytes at most.
+func (d *contDecoder) size(n uint64, t reflect.Type) {
+	if size := uint64(t.Size()); size != 0 && n > uint64(len(d.data))*32/size {
+		contErrorf("bad continuation data")
+	}
+}
+
+func (d *contDecoder) string() string {
+	n := d.uint()
+	if n > uint64(len(d.data)) {
+		contErrorf("bad continuation data")
+	}
+	s := string(d.data[:n])
+	d.data = d.data[n:]
+	return s
+}
+
+func (d *contDecoder) iface() value {
+	t := d.typ()
+	if t == nil {
+		return nil
+	}
+	d.size(1, t)
+	v := reflect.New(t).Elem()
+	d.value(v)
+	return v.Interface()
+}
+
+func (d *contDecoder) typ() reflect.Type {
+	switch d.uint() {
+	case typeNil:
+		return nil
+	case typeTuple:
+		return typeOfTuple
+	case typeError:
+		return typeOfError
+	case typeEmptyInterface:
+		return typeOfEmptyInterface
+	case typeBasic:
+		if t, ok := basicTypes[reflect.Kind(d.uint())]; ok {
+			return t
+		}
+	case typeNamed:
+		path, name := d.string(), d.string()
+		if t, ok := d.interp.namedType(path, name); ok {
+			return t
+		}
+		contErrorf("not found type %v.%v", path, name)
+	case typePtr:
+		return reflect.PtrTo(d.typ())
+	case typeSlice:
+		return reflect.SliceOf(d.typ())
+	case typeArray:
+		n, elem := d.uint(), d.typ()
+		d.size(n, elem)
+		return reflect.ArrayOf(int(n), elem)
+	case typeMap:
+		k := d.typ()
+		return reflect.MapOf(k, d.typ())
+	case typeFunc:
+		in := make([]reflect.Type, d.len())
+		for i := range in {
+			in[i] = d.typ()
+		}
+		out := make([]reflect.Type, d.len())
+		for i := range out {
+			out[i] = d.typ()
+		}
+		return reflect.FuncOf(in, out, d.uint() == 1)
+	case typeStruct:
+		fs := make([]reflect.StructField, d.len())
+		exported := true
+		for i := range fs {
+			fs[i].Name = d.string()
+			fs[i].PkgPath = d.string()
+			fs[i].Tag = reflect.StructTag(d.string())
+			fs[i].Anonymous = d.uint() == 1
+			fs[i].Type = d.typ()
+			if fs[i].PkgPath != "" {
+				exported = false
+			}
+		}
+		if exported {
+			return reflect.StructOf(fs)
+		}
+		return reflectx.StructOf(fs)
+	}
+	contErrorf("bad continuation data")
+	return nil
+}
+
+func (d *contDecoder) value(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(d.uint() == 1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(d.int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(d.uint())
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(math.Float64frombits(d.uint()))
+	case reflect.Complex64, reflect.Complex128:
+		re := math.Float64frombits(d.uint())
+		v.SetComplex(complex(re, math.Float64frombits(d.uint())))
+	case reflect.String:
+		v.SetString(d.string())
+	case reflect.Interface:
+		if t := d.typ(); t != nil {
+			if !t.AssignableTo(v.Type()) {
+				contErrorf("type %v not assignable to %v", t, v.Type())
+			}
+			d.size(1, t)
+			x := reflect.New(t).Elem()
+			d.value(x)
+			v.Set(x)
+		}
+	case reflect.Ptr:
+		t := v.Type().Elem()
+		switch tag, p, off := d.ref(); tag {
+		case tagNew:
+			if t.Size() != 0 {
+				contErrorf("bad continuation data")
+			}
+			v.Set(reflect.New(t))
+		case tagRef:
+			if !typeAt(p.Type().Elem(), off, t) {
+				contErrorf("bad pointer of %v", v.Type())
+			}
+			base := unsafe.Pointer(p.Pointer())
+			v.Set(reflect.NewAt(t, unsafe.Pointer(uintptr(base)+off)))
+		}
+	case reflect.Slice:
+		tag, p, off := d.ref()
+		if tag == tagNil {
+			return
+		}
+		n, c := d.uint(), d.uint()
+		if n > c {
+			contErrorf("bad continuation data")
+		}
+		t := v.Type().Elem()
+		if tag == tagNew {
+			if c != 0 && t.Size() != 0 {
+				contErrorf("bad continuation data")
+			}
+			v.Set(reflect.MakeSlice(v.Type(), int(n), int(c)))
+			return
+		}
+		if t.Size() == 0 || c > uint64(p.Type().Elem().Size()/t.Size()) {
+			contErrorf("bad slice of %v", v.Type())
+		}
+		at := reflect.ArrayOf(int(c), t)
+		if !typeAt(p.Type().Elem(), off, at) {
+			contErrorf("bad slice of %v", v.Type())
+		}
+		base := unsafe.Pointer(p.Pointer())
+		v.Set(reflect.NewAt(at, unsafe.Pointer(uintptr(base)+off)).Elem().Slice3(0, int(n), int(c)))
+	case reflect.Array:
+		if v.Type().Size() == 0 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			d.value(v.Index(i))
+		}
+	case reflect.Map:
+		switch d.uint() {
+		case tagNil:
+			return
+		case tagRef:
+			id := d.uint()
+			if id >= uint64(len(d.maps)) || d.maps[id].Type() != v.Type() {
+				contErrorf("bad continuation data")
+			}
+			v.Set(d.maps[id])
+			return
+		case tagNew:
+		default:
+			contErrorf("bad continuation data")
+		}
+		t := v.Type()
+		m := reflect.MakeMap(t)
+		d.maps = append(d.maps, m)
+		for n := d.len(); n > 0; n-- {
+			k := reflect.New(t.Key()).Elem()
+			d.value(k)
+			e := reflect.New(t.Elem()).Elem()
+			d.value(e)
+			m.SetMapIndex(k, e)
+		}
+		v.Set(m)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			d.value(fieldOf(v, i))
+		}
+	case reflect.Func:
+		if d.uint() == tagNil {
+			return
+		}
+		name := d.string()
+		pfn, ok := d.funcs[name]
+		if !ok {
+			contErrorf("not found function %v", name)
+		}
+		env := make([]value, d.len())
+		if len(env) != pfn.nenv || !v.Type().AssignableTo(d.interp.toType(pfn.Fn.Type())) {
+			contErrorf("bad closure of function %v", name)
+		}
+		for i := range env {
+			env[i] = d.iface()
+		}
+		v.Set(pfn.makeFunction(v.Type(), env))
+	default:
+		contErrorf("value of %v not supported", v.Type())
+	}
+}
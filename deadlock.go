@@ -0,0 +1,217 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// goroutine wait reason like runtime
+const (
+	waitReasonChanReceive   = "chan receive"
+	waitReasonChanSend      = "chan send"
+	waitReasonSelect        = "select"
+	waitReasonSelectNoCases = "select (no cases)"
+	waitReasonWaitGroup     = "sync.WaitGroup.Wait"
+)
+
+func init() {
+	RegisterExternal("time.AfterFunc", timeAfterFunc)
+}
+
+// timeAfterFunc count the pending timer as alive goroutine for check deadlock,
+// the stopped timer keep alive.
+func timeAfterFunc(fr *frame, d time.Duration, f func()) *time.Timer {
+	interp := fr.interp
+	dc := interp.deadlock
+	if dc == nil {
+		return time.AfterFunc(d, f)
+	}
+	atomic.AddInt32(&interp.goroutines, 1)
+	return time.AfterFunc(d, func() {
+		defer dc.exit(dc.enter())
+		defer atomic.AddInt32(&interp.goroutines, -1)
+		f()
+	})
+}
+
+// deadlockConfirm is the time of all goroutines keep asleep to report deadlock.
+var deadlockConfirm = 100 * time.Millisecond
+
+func chanWaitReason(ch reflect.Value, reason string) string {
+	if ch.IsNil() {
+		return reason + " (nil chan)"
+	}
+	return reason
+}
+
+type waitGoroutine struct {
+	fr     *frame
+	reason string
+}
+
+// deadlockChecker track the interp goroutines blocked on channel operations
+// and sync.WaitGroup.Wait. Channels make by native code (timers, context) may
+// be wake up by native, goroutines blocked on them are not asleep.
+type deadlockChecker struct {
+	interp   *Interp
+	chans    sync.Map // chan pointer -> make by interp
+	goids    sync.Map // goroutine id -> interp goroutine
+	waits    sync.Map // goroutine id -> *waitGoroutine
+	asleep   int32    // number of asleep goroutines
+	wakeups  uint32   // number of wake up
+	checking int32    // checking deadlock
+	once     sync.Once
+	err      error // DeadlockError set before abort
+}
+
+func newDeadlockChecker(interp *Interp) *deadlockChecker {
+	return &deadlockChecker{interp: interp}
+}
+
+// makeChan record the channel make by interp.
+func (d *deadlockChecker) makeChan(ch reflect.Value) {
+	d.chans.Store(ch.Pointer(), true)
+}
+
+// isLocalChan report the channel is nil or make by interp.
+func (d *deadlockChecker) isLocalChan(ch reflect.Value) bool {
+	if ch.IsNil() {
+		return true
+	}
+	_, ok := d.chans.Load(ch.Pointer())
+	return ok
+}
+
+// isLocalCases report the channels of select cases are all local.
+func (d *deadlockChecker) isLocalCases(cases []reflect.SelectCase) bool {
+	for _, c := range cases {
+		if !d.isLocalChan(c.Chan) {
+			return false
+		}
+	}
+	return true
+}
+
+// enter record the current goroutine is interp goroutine.
+func (d *deadlockChecker) enter() int64 {
+	id := goroutineID()
+	d.goids.Store(id, true)
+	return id
+}
+
+// exit remove the interp goroutine and check deadlock.
+func (d *deadlockChecker) exit(id int64) {
+	d.goids.Delete(id)
+	d.check()
+}
+
+// block record the current goroutine asleep if local, return the goroutine id
+// for unblock, zero if not asleep.
+func (d *deadlockChecker) block(fr *frame, reason string, local bool) int64 {
+	if !local {
+		return 0
+	}
+	id := goroutineID()
+	if _, ok := d.goids.Load(id); !ok {
+		return 0
+	}
+	d.waits.Store(id, &waitGoroutine{fr: fr, reason: reason})
+	atomic.AddInt32(&d.asleep, 1)
+	d.check()
+	return id
+}
+
+func (d *deadlockChecker) unblock(id int64) {
+	if id == 0 {
+		return
+	}
+	atomic.AddUint32(&d.wakeups, 1)
+	atomic.AddInt32(&d.asleep, -1)
+	d.waits.Delete(id)
+}
+
+func (d *deadlockChecker) allAsleep() bool {
+	n := atomic.LoadInt32(&d.asleep)
+	return n > 0 && n == atomic.LoadInt32(&d.interp.goroutines)
+}
+
+// check start confirm deadlock if all goroutines are asleep.
+func (d *deadlockChecker) check() {
+	if !d.allAsleep() || !atomic.CompareAndSwapInt32(&d.checking, 0, 1) {
+		return
+	}
+	go func() {
+		for d.allAsleep() {
+			wakeups := atomic.LoadUint32(&d.wakeups)
+			timer := time.NewTimer(deadlockConfirm)
+			select {
+			case <-timer.C:
+			case <-d.interp.chabort:
+				timer.Stop()
+				return
+			}
+			if d.allAsleep() && atomic.LoadUint32(&d.wakeups) == wakeups {
+				d.deadlocked()
+				return
+			}
+		}
+		atomic.StoreInt32(&d.checking, 0)
+		// goroutines asleep before reset checking
+		d.check()
+	}()
+}
+
+// dump return the stack of asleep goroutines.
+func (d *deadlockChecker) dump() []byte {
+	var ids []int64
+	d.waits.Range(func(k, v interface{}) bool {
+		ids = append(ids, k.(int64))
+		return true
+	})
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	var buf bytes.Buffer
+	for n, id := range ids {
+		v, ok := d.waits.Load(id)
+		if !ok {
+			continue
+		}
+		w := v.(*waitGoroutine)
+		if n > 0 {
+			buf.WriteByte('\n')
+		}
+		fmt.Fprintf(&buf, "goroutine %v [%v]:\n", id, w.reason)
+		writeStack(&buf, w.fr)
+	}
+	return buf.Bytes()
+}
+
+// deadlocked abort interp with DeadlockError.
+func (d *deadlockChecker) deadlocked() {
+	d.once.Do(func() {
+		d.err = DeadlockError{stack: d.dump()}
+		d.interp.Abort()
+	})
+}
@@ -26,8 +26,17 @@ var (
 	ErrTestFailed      = errors.New("test failed")
 	ErrNotFoundPackage = errors.New("not found package")
 	ErrGoexitDeadlock  = errors.New("fatal error: no goroutines (main called runtime.Goexit) - deadlock!")
+	ErrDeadlock        = errors.New("fatal error: all goroutines are asleep - deadlock!")
+	ErrGoroutineLeak   = errors.New("found unexpected goroutines")
+	ErrReplayDiverged  = errors.New("replay diverged from trace")
 	ErrNoFunction      = errors.New("no function")
 	ErrNoTestFiles     = errors.New("[no test files]")
+	ErrProgramMode     = errors.New("mode not supported by shared program")
+	ErrNoInterp        = errors.New("goroutine not run by interp of program")
+	ErrStackOverflow   = errors.New("fatal error: stack overflow")
+	ErrNotSuspendable  = errors.New("goroutine not suspendable")
+	ErrResumed         = errors.New("continuation already resumed")
+	ErrNoPlugin        = errors.New("plugin not supported, build with tag igop_plugin")
 )
 
 type ExitError int
@@ -36,6 +45,44 @@ func (r ExitError) Error() string {
 	return fmt.Sprintf("exit %v", int(r))
 }
 
+// DeadlockError is the error of all goroutines are asleep, check by
+// CheckDeadlock mode.
+type DeadlockError struct {
+	stack []byte
+}
+
+func (e DeadlockError) Error() string {
+	return ErrDeadlock.Error()
+}
+
+func (e DeadlockError) Unwrap() error {
+	return ErrDeadlock
+}
+
+// Stack return the stack of asleep goroutines.
+func (e DeadlockError) Stack() []byte {
+	return e.stack
+}
+
+// GoroutineLeakError is the error of goroutines alive after main returns,
+// check by CheckGoroutineLeak mode.
+type GoroutineLeakError struct {
+	stack []byte
+}
+
+func (e GoroutineLeakError) Error() string {
+	return ErrGoroutineLeak.Error()
+}
+
+func (e GoroutineLeakError) Unwrap() error {
+	return ErrGoroutineLeak
+}
+
+// Stack return the stack and created position of leaked goroutines.
+func (e GoroutineLeakError) Stack() []byte {
+	return e.stack
+}
+
 type plainError string
 
 func (e plainError) RuntimeError() {}
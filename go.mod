@@ -15,6 +15,6 @@ require (
 	github.com/visualfc/gid v0.1.0
 	github.com/visualfc/goembed v0.3.2
 	github.com/visualfc/xtype v0.2.0
-	golang.org/x/mod v0.17.0
-	golang.org/x/tools v0.19.0
+	golang.org/x/mod v0.20.0
+	golang.org/x/tools v0.24.0
 )
@@ -63,14 +63,29 @@ func (i *Importer) Import(path string) (*types.Package, error) {
 		return pkg.Package, nil
 	}
 	if dir, found := i.ctx.lookupPath(path); found {
-		pkg, err := i.ctx.addImport(path, dir)
-		if err != nil {
-			return nil, err
-		}
-		if err := pkg.Load(); err != nil {
-			return nil, err
+		return i.importDir(path, dir)
+	}
+	if i.ctx.Missing != nil {
+		if dir, found := i.ctx.Missing(i.ctx.root, path); found {
+			if dir != "" {
+				return i.importDir(path, dir)
+			}
+			if pkg, err := i.ctx.Loader.Import(path); err == nil && pkg.Complete() {
+				i.pkgs[path] = pkg
+				return pkg, nil
+			}
 		}
-		return pkg.Package, nil
 	}
 	return nil, ErrNotFoundPackage
 }
+
+func (i *Importer) importDir(path string, dir string) (*types.Package, error) {
+	pkg, err := i.ctx.addImport(path, dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := pkg.Load(); err != nil {
+		return nil, err
+	}
+	return pkg.Package, nil
+}
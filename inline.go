@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"go/token"
+	"sync/atomic"
+
+	"golang.org/x/tools/go/ssa"
+)
+
+// maxInlineInstrs is the max count of callee instrs to inline.
+const maxInlineInstrs = 16
+
+// inlineBuiltins is the builtins not need frame of callee.
+var inlineBuiltins = map[string]bool{
+	"len":    true,
+	"cap":    true,
+	"append": true,
+	"copy":   true,
+}
+
+// inlineCallee return the callee of call can inline, it is a leaf function
+// or closure of one block, small, no defer and recover. The leaf has no call
+// to observe runtime.Caller, the panic in callee keep it in stack by a frame
+// made on panic.
+func inlineCallee(call *ssa.Call) *ssa.Function {
+	common := &call.Call
+	if common.IsInvoke() {
+		return nil
+	}
+	var fn *ssa.Function
+	switch v := common.Value.(type) {
+	case *ssa.Function:
+		fn = v
+	case *ssa.MakeClosure:
+		fn, _ = v.Fn.(*ssa.Function)
+	}
+	if fn == nil || fn == call.Parent() || len(fn.Blocks) != 1 || fn.Recover != nil {
+		return nil
+	}
+	var n int
+	for _, instr := range fn.Blocks[0].Instrs {
+		switch instr := instr.(type) {
+		case *ssa.DebugRef:
+			continue
+		case *ssa.BinOp, *ssa.Convert, *ssa.ChangeType, *ssa.ChangeInterface,
+			*ssa.MakeInterface, *ssa.SliceToArrayPointer, *ssa.Field, *ssa.FieldAddr,
+			*ssa.Index, *ssa.IndexAddr, *ssa.Lookup, *ssa.Slice, *ssa.Extract,
+			*ssa.TypeAssert, *ssa.Store, *ssa.Alloc, *ssa.MakeSlice, *ssa.MakeMap,
+			*ssa.MapUpdate, *ssa.Return:
+		case *ssa.UnOp:
+			if instr.Op == token.ARROW {
+				return nil
+			}
+		case *ssa.Call:
+			if b, ok := instr.Call.Value.(*ssa.Builtin); !ok || !inlineBuiltins[b.Name()] {
+				return nil
+			}
+		default:
+			return nil
+		}
+		if n++; n > maxInlineInstrs {
+			return nil
+		}
+	}
+	return fn
+}
+
+// makeInlineCall make the instr run the callee instrs in caller frame, the
+// callee values use registers of caller. It call by ifn if callee is patched,
+// return nil if callee can not inline.
+func makeInlineCall(interp *Interp, pfn *function, call *ssa.Call, ifn func(fr *frame)) func(fr *frame) {
+	fn := inlineCallee(call)
+	if fn == nil || (pfn.Fn.Name() == "init" && pfn.Fn.Synthetic == "package initializer") || interp.ctx.suspends[fn.String()] {
+		return nil
+	}
+	callee, ok := interp.funcs[fn]
+	if !ok {
+		return nil
+	}
+	if atomic.LoadInt32(&callee.compiled) == 0 {
+		// in visiting if EagerCompile
+		if interp.conf.mode&EagerCompile != 0 {
+			return nil
+		}
+		callee.build()
+	}
+	if len(callee.ssaInstrs) == 0 {
+		return nil
+	}
+	var pa, ia []register
+	for n, p := range fn.Params {
+		if refs := p.Referrers(); refs != nil && len(*refs) > 0 {
+			pa = append(pa, pfn.regIndex(p))
+			ia = append(ia, pfn.regIndex(call.Call.Args[n]))
+		}
+	}
+	if c, ok := call.Call.Value.(*ssa.MakeClosure); ok {
+		for n, p := range fn.FreeVars {
+			pa = append(pa, pfn.regIndex(p))
+			ia = append(ia, pfn.regIndex(c.Bindings[n]))
+		}
+	}
+	var body []func(fr *frame)
+	var pcs []int
+	var results []register
+	for _, instr := range fn.Blocks[0].Instrs {
+		switch instr := instr.(type) {
+		case *ssa.DebugRef:
+			continue
+		case *ssa.Return:
+			for _, v := range instr.Results {
+				results = append(results, pfn.regIndex(v))
+			}
+			continue
+		}
+		pfn.makeInstr = instr
+		f := makeInstr(interp, pfn, instr)
+		pfn.makeInstr = call
+		if f == nil {
+			continue
+		}
+		var pc int
+		for pc < len(callee.ssaInstrs) && callee.ssaInstrs[pc] != instr {
+			pc++
+		}
+		body = append(body, f)
+		pcs = append(pcs, pc)
+	}
+	ir := pfn.regIndex(call)
+	return func(fr *frame) {
+		if atomic.LoadInt32(&callee.patched) != 0 {
+			ifn(fr)
+			return
+		}
+		for n, r := range pa {
+			fr.stack[r] = fr.stack[ia[n]]
+		}
+		var n int
+		defer func() {
+			if n < len(body) {
+				// make the frame of callee for stack of panic
+				fr.callee = &frame{interp: fr.interp, caller: fr, pfn: fr.interp.funcOf(callee), block: callee.Main, ipc: pcs[n] + 1}
+			}
+		}()
+		for ; n < len(body); n++ {
+			body[n](fr)
+		}
+		switch len(results) {
+		case 0:
+		case 1:
+			fr.stack[ir] = fr.stack[results[0]]
+		default:
+			res := make(tuple, len(results))
+			for i, r := range results {
+				res[i] = fr.stack[r]
+			}
+			fr.stack[ir] = res
+		}
+	}
+}
@@ -56,6 +56,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"github.com/goplus/igop/load"
@@ -80,8 +81,20 @@ func init() {
 	}
 }
 
+// interpConfig is the settings of Context at interp created, the functions
+// compiled lazily and the calls use them instead of Context.
+type interpConfig struct {
+	mode        Mode
+	optLevel    int
+	callForPool int
+	maxDepth    int
+	evalMode    bool
+	debugFunc   func(*DebugInfo)
+}
+
 type Interp struct {
 	ctx          *Context
+	conf         interpConfig                                // settings of context at created
 	mainpkg      *ssa.Package                                // the SSA main package
 	record       *TypesRecord                                // lookup type and ToType
 	globals      map[string]value                            // addresses of global variables (immutable)
@@ -91,6 +104,17 @@ type Interp struct {
 	msets        map[reflect.Type](map[string]*ssa.Function) // user defined type method sets
 	chexit       chan int                                    // call os.Exit code by chan for runtime.Goexit
 	cherror      chan PanicError                             // call by go func error for context
+	chabort      chan struct{}                               // closed by Abort for wake up blocking operations
+	deadlock     *deadlockChecker                            // check all goroutines are asleep, nil if disabled
+	race         *raceDetector                               // data race detector, nil if disabled
+	leak         *leakChecker                                // goroutine leak checker, nil if disabled
+	sched        *scheduler                                  // deterministic scheduler, nil if disabled
+	clock        *VirtualClock                               // virtual clock of time, nil if real time
+	recorder     *recorder                                   // record or replay external calls, nil if disabled
+	natives      map[string]*nativePatch                     // patchable native functions mocked by context
+	program      *Program                                    // compiled program shared by interps, nil if not
+	fns          []*function                                 // functions instantiated from program by id
+	abortOnce    sync.Once                                   // close chabort once
 	deferMap     sync.Map                                    // defer goroutine id -> call frame
 	rfuncMap     sync.Map                                    // reflect.Value(fn).Pointer -> *function
 	typesMutex   sync.RWMutex                                // findType/toType mutex
@@ -130,11 +154,24 @@ func (i *Interp) loadFunction(fn *ssa.Function) *function {
 		pfn.nres = res.Len()
 		pfn.stack = make([]value, pfn.nres)
 	}
+	pfn.id = len(i.funcs)
 	i.funcs[fn] = pfn
 	return pfn
 }
 
+// funcOf return the function run by interp, the function compiled by
+// Program is instantiated by each interp.
+func (i *Interp) funcOf(pfn *function) *function {
+	if i.fns != nil && pfn.Interp != i {
+		return i.fns[pfn.id]
+	}
+	return pfn
+}
+
 func (i *Interp) findType(rt reflect.Type, local bool) (types.Type, bool) {
+	if p := i.program; p != nil && p.interp != i {
+		return p.interp.findType(rt, local)
+	}
 	i.typesMutex.Lock()
 	defer i.typesMutex.Unlock()
 	if local {
@@ -154,7 +191,17 @@ func (i *Interp) tryDeferFrame() *frame {
 }
 
 func (pfn *function) callFunctionByReflect(mtyp reflect.Type, args []reflect.Value, env []interface{}) []reflect.Value {
-	return pfn.Interp.callFunctionByReflect(pfn.Interp.tryDeferFrame(), mtyp, pfn, args, env)
+	i := pfn.Interp
+	if p := i.program; p != nil {
+		// the methods of types shared by program, call by the running interp
+		v, ok := p.running.Load(goroutineID())
+		if !ok {
+			panic(fmt.Errorf("call %v: %w", pfn.Fn, ErrNoInterp))
+		}
+		i = v.(*Interp)
+		pfn = i.funcOf(pfn)
+	}
+	return i.callFunctionByReflect(i.tryDeferFrame(), mtyp, pfn, args, env)
 }
 
 func (i *Interp) FindMethod(mtyp reflect.Type, fn *types.Func) func([]reflect.Value) []reflect.Value {
@@ -181,7 +228,14 @@ func (i *Interp) FindMethod(mtyp reflect.Type, fn *types.Func) func([]reflect.Va
 
 func (pfn *function) makeFunction(typ reflect.Type, env []value) reflect.Value {
 	return reflect.MakeFunc(typ, func(args []reflect.Value) []reflect.Value {
-		return pfn.Interp.callFunctionByReflect(pfn.Interp.tryDeferFrame(), typ, pfn, args, env)
+		i := pfn.Interp
+		if p := i.program; p != nil {
+			// the goroutine started by native run the methods by interp of closure
+			if _, ok := p.running.Load(goroutineID()); !ok {
+				defer p.running.Delete(p.enter(i))
+			}
+		}
+		return i.callFunctionByReflect(i.tryDeferFrame(), typ, pfn, args, env)
 	})
 }
 
@@ -200,9 +254,14 @@ type frame struct {
 	_defer  *_defer
 	_panic  *_panic
 	block   *ssa.BasicBlock
-	stack   []value // result args env datas
+	stack   []value   // result args env datas
+	ints    []uint64  // unboxed integer and bool values
+	floats  []float64 // unboxed float values
+	next    *frame    // callee pushed on heap, run by loop of caller
 	ipc     int
 	pred    int
+	depth   int      // depth of call stack
+	ir      register // register of caller set by results on heap return
 	deferid int64
 }
 
@@ -646,6 +705,9 @@ func (i *Interp) prepareCall(fr *frame, call *ssa.CallCommon, iv register, ia []
 			if !ok {
 				panic(fmt.Errorf("no code for method: %v.%v", rtype, mname))
 			}
+			if r := i.recorder; r != nil {
+				ext = r.method(rtype, mname, ext)
+			}
 			fv = ext
 		}
 		args = append(args, v)
@@ -678,6 +740,42 @@ func (i *Interp) call(caller *frame, fn value, args []value, ssaArgs []ssa.Value
 // call interprets a call to a function (function, builtin or closure)
 // fn with arguments args, returning its result.
 // callpos is the position of the callsite.
+// goCall call fn in the new goroutine, the panic send to cherror if run
+// with context.
+func (i *Interp) goCall(fn value, args []value, ssaArgs []ssa.Value) {
+	if p := i.program; p != nil {
+		defer p.running.Delete(p.enter(i))
+	}
+	if i.ctx.RunContext == nil {
+		root := &frame{}
+		if l := i.leak; l != nil {
+			l.start(root)
+		}
+		i.callDiscardsResult(root, fn, args, ssaArgs)
+		return
+	}
+	root := &frame{interp: i}
+	if l := i.leak; l != nil {
+		l.start(root)
+	}
+	switch f := fn.(type) {
+	case *ssa.Function:
+		root.pfn = i.funcs[f]
+	case *closure:
+		root.pfn = f.pfn
+	}
+	defer func() {
+		e := recover()
+		if e != nil {
+			select {
+			case i.cherror <- PanicError{stack: debugStack(root), Value: e}:
+			case <-i.chabort:
+			}
+		}
+	}()
+	i.callDiscardsResult(root, fn, args, ssaArgs)
+}
+
 func (i *Interp) callDiscardsResult(caller *frame, fn value, args []value, ssaArgs []ssa.Value) {
 	switch fn := fn.(type) {
 	case *ssa.Function:
@@ -993,6 +1091,28 @@ func (i *Interp) callExternalByStack(caller *frame, fn reflect.Value, ir registe
 	}
 }
 
+func (i *Interp) callThunkByStack(caller *frame, thunk CallThunk, nres int, ir register, ia []register) {
+	if caller.deferid != 0 {
+		i.deferMap.Store(caller.deferid, caller)
+	}
+	args := make([]value, len(ia))
+	for i := range ia {
+		args[i] = caller.reg(ia[i])
+	}
+	switch nres {
+	case 0:
+		thunk(args, nil)
+	case 1:
+		var res [1]value
+		thunk(args, res[:])
+		caller.setReg(ir, res[0])
+	default:
+		res := make(tuple, nres)
+		thunk(args, res)
+		caller.setReg(ir, res)
+	}
+}
+
 func (i *Interp) callExternalWithFrameByStack(caller *frame, fn reflect.Value, ir register, ia []register) {
 	if caller.deferid != 0 {
 		i.deferMap.Store(caller.deferid, caller)
@@ -1065,51 +1185,159 @@ func (fr *frame) run() {
 			if fr.ipc == -1 || fr._defer == nil {
 				return // normal return
 			}
-			fr._panic = &_panic{arg: recover()}
-			callee := fr.callee
-			for callee.aborted() {
-				if !callee._panic.isNil() {
-					if !callee._panic.link.isNil() {
-						fr._panic.link = callee._panic.link
-						// check panic link
-						link := callee._panic.link
-						for link.link != nil {
-							link = link.link
-						}
-						link.pcs = append(link.pcs, callee.pc())
-					} else {
-						fr._panic.pcs = append([]uintptr{callee.pc()}, fr._panic.pcs...)
-					}
-					fr._panic.pcs = append(append([]uintptr{}, callee._panic.pcs...), fr._panic.pcs...)
-				} else {
-					fr._panic.pcs = append([]uintptr{callee.pc()}, fr._panic.pcs...)
-				}
-				callee = callee.callee
-			}
-			fr.runDefers()
-			for _, fn := range fr.pfn.Recover {
-				fn(fr)
+			if atomic.LoadInt32(&fr.interp.exited) == 1 {
+				return // exit or abort, skip defers
 			}
+			fr.runPanic(recover())
 		}()
 	}
 
-	for fr.ipc != -1 && atomic.LoadInt32(&fr.interp.exited) == 0 {
-		fn := fr.pfn.Instrs[fr.ipc]
-		fr.ipc++
+	fr.loop(fr)
+}
+
+// runPanic run the defers and recover block of fr on panic p, it panic
+// again if p not recovered.
+func (fr *frame) runPanic(p interface{}) {
+	if l := fr.interp.leak; l != nil {
+		l.start(fr)
+	}
+	fr._panic = &_panic{arg: p}
+	callee := fr.callee
+	for callee.aborted() {
+		if !callee._panic.isNil() {
+			if !callee._panic.link.isNil() {
+				fr._panic.link = callee._panic.link
+				// check panic link
+				link := callee._panic.link
+				for link.link != nil {
+					link = link.link
+				}
+				link.pcs = append(link.pcs, callee.pc())
+			} else {
+				fr._panic.pcs = append([]uintptr{callee.pc()}, fr._panic.pcs...)
+			}
+			fr._panic.pcs = append(append([]uintptr{}, callee._panic.pcs...), fr._panic.pcs...)
+		} else {
+			fr._panic.pcs = append([]uintptr{callee.pc()}, fr._panic.pcs...)
+		}
+		callee = callee.callee
+	}
+	fr.runDefers()
+	for _, fn := range fr.pfn.Recover {
 		fn(fr)
 	}
 }
 
+// tryPanic is runPanic return the new panic instead of panic.
+func (fr *frame) tryPanic(p interface{}) (np interface{}, panicked bool) {
+	defer func() {
+		if panicked {
+			np = recover()
+		}
+	}()
+	panicked = true
+	fr.runPanic(p)
+	panicked = false
+	return
+}
+
+// loop run the instrs of cur and the callees pushed on heap by pushFrame
+// until fr returned, cur is fr or the callee of fr resumed. The interpreted
+// call not recurse on goroutine stack.
+func (fr *frame) loop(cur *frame) {
+	for {
+		cur = fr.exec(cur)
+		if cur == fr || cur.ipc != -1 {
+			return
+		}
+		caller := cur.caller
+		switch n := cur.pfn.nres; n {
+		case 0:
+		case 1:
+			caller.setReg(cur.ir, cur.stack[0])
+		default:
+			caller.setReg(cur.ir, tuple(cur.stack[0:n]))
+		}
+		cur.pfn.deleteFrame(caller, cur)
+		cur = caller
+	}
+}
+
+// exec run the instrs from cur until a frame returned. The panic run the
+// defers of callees on heap like run, the callee recovered is returned.
+func (fr *frame) exec(cur *frame) (last *frame) {
+	defer func() {
+		if last != nil || atomic.LoadInt32(&fr.interp.exited) == 1 {
+			return
+		}
+		h := cur.deferFrame(fr)
+		if h == nil {
+			return // panic to fr
+		}
+		p := recover()
+		for {
+			np, panicked := h.tryPanic(p)
+			if !panicked {
+				last = h
+				return
+			}
+			if h, p = h.caller.deferFrame(fr), np; h == nil {
+				panic(p)
+			}
+		}
+	}()
+	for cur.ipc != -1 && atomic.LoadInt32(&fr.interp.exited) == 0 {
+		fn := cur.pfn.Instrs[cur.ipc]
+		cur.ipc++
+		fn(cur)
+		if next := cur.next; next != nil {
+			cur.next = nil
+			cur = next
+		}
+	}
+	return cur
+}
+
+// deferFrame return fr or the first caller run defers on panic, the
+// callers on heap stop at top.
+func (fr *frame) deferFrame(top *frame) *frame {
+	for ; fr != top; fr = fr.caller {
+		if fr.pfn.Recover != nil && fr._defer != nil && fr.ipc != -1 {
+			return fr
+		}
+	}
+	return nil
+}
+
+// pushFrame push the frame of p called by caller, it run by loop of
+// caller and set the results to ir on return. The defers of callee run on
+// panic by exec.
+func (p *function) pushFrame(caller *frame, ir register, ia []register, env []value) {
+	fr := p.allocFrame(caller)
+	nres := fr.pfn.nres
+	for i := 0; i < len(ia); i++ {
+		fr.stack[i+nres] = caller.reg(ia[i])
+	}
+	for i := 0; i < len(env); i++ {
+		fr.stack[len(ia)+i+nres] = env[i]
+	}
+	fr.ir = ir
+	caller.next = fr
+}
+
 // doRecover implements the recover() built-in.
 func doRecover(caller *frame) value {
 	// recover() must be exactly one level beneath the deferred
 	// function (two levels beneath the panicking function) to
 	// have any effect.  Thus we ignore both "defer recover()" and
 	// "defer f() -> g() -> recover()".
-	if caller.interp.ctx.Mode&DisableRecover == 0 &&
+	if caller.interp.conf.mode&DisableRecover == 0 &&
 		caller._panic.isNil() &&
 		caller.caller != nil && !caller.caller._panic.isNil() {
 		p := caller.caller._panic.arg
+		if e, ok := p.(PanicError); ok && e.Value == ErrStackOverflow {
+			return nil // fatal error
+		}
 		caller.caller._panic.recovered = true
 		switch p := p.(type) {
 		case PanicError:
@@ -1134,12 +1362,13 @@ func doRecover(caller *frame) value {
 //
 
 func NewInterp(ctx *Context, mainpkg *ssa.Package) (*Interp, error) {
-	return newInterp(ctx, mainpkg, nil)
+	return newInterp(ctx, mainpkg, nil, nil)
 }
 
-func newInterp(ctx *Context, mainpkg *ssa.Package, globals map[string]interface{}) (*Interp, error) {
+func newInterp(ctx *Context, mainpkg *ssa.Package, globals map[string]interface{}, prog *Program) (*Interp, error) {
 	i := &Interp{
 		ctx:          ctx,
+		conf:         ctx.config(),
 		mainpkg:      mainpkg,
 		globals:      make(map[string]value),
 		chkinit:      make(map[string]bool),
@@ -1148,7 +1377,41 @@ func newInterp(ctx *Context, mainpkg *ssa.Package, globals map[string]interface{
 		funcs:        make(map[*ssa.Function]*function),
 		msets:        make(map[reflect.Type](map[string]*ssa.Function)),
 		chexit:       make(chan int),
+		chabort:      make(chan struct{}),
 		mainid:       goroutineID(),
+		program:      prog,
+	}
+	if ctx.Mode&CheckDeadlock != 0 {
+		i.deadlock = newDeadlockChecker(i)
+	}
+	if ctx.Mode&DeterministicSchedule != 0 {
+		i.sched = newScheduler(i, ctx.schedSeed, ctx.schedQuantum)
+	}
+	if c := ctx.clock; c != nil {
+		i.clock = c
+		c.mu.Lock()
+		c.interp = i
+		c.mu.Unlock()
+	}
+	if r := ctx.record; r != nil {
+		if err := r.start(ctx); err != nil {
+			return nil, err
+		}
+		i.recorder = r
+	}
+	if len(ctx.mocks) > 0 {
+		i.natives = make(map[string]*nativePatch)
+		for symbol := range ctx.mocks {
+			i.natives[symbol] = &nativePatch{}
+		}
+	}
+	if ctx.Mode&CheckGoroutineLeak != 0 {
+		i.leak = newLeakChecker(i)
+	}
+	if ctx.Mode&CheckDataRace != 0 {
+		i.race = newRaceDetector(i)
+		// main goroutine is the first race thread
+		i.race.current()
 	}
 	var rctx *reflectx.Context
 	if ctx.Mode&SupportMultipleInterp == 0 {
@@ -1160,9 +1423,34 @@ func newInterp(ctx *Context, mainpkg *ssa.Package, globals map[string]interface{
 	i.record = NewTypesRecord(rctx, ctx.Loader, i, ctx.nestedMap)
 	i.record.Load(mainpkg)
 
-	var pkgs []*ssa.Package
+	pkgs, links := i.initGlobals(globals)
+	// static types check
+	err := checkPackages(i, pkgs)
+	if err != nil {
+		return i, err
+	}
+	// check linkname duplicated definition
+	for _, link := range links {
+		localName, targetName := link.PkgPath+"."+link.Name, link.Linkname.PkgPath+"."+link.Linkname.Name
+		if i.chkinit[localName] && i.chkinit[targetName] {
+			return i, fmt.Errorf("duplicated definition of symbol %v, from %v and %v", targetName, link.PkgPath, link.Linkname.PkgPath)
+		}
+	}
+	for symbol, fn := range ctx.mocks {
+		if prog != nil && !i.isNativePatch(symbol) {
+			continue // patch by each interp of program
+		}
+		if _, err := i.Patch(symbol, fn); err != nil {
+			return i, err
+		}
+	}
+	return i, err
+}
 
-	for _, pkg := range mainpkg.Prog.AllPackages() {
+// initGlobals initialize the global storage of packages, globals is the
+// values of repl.
+func (i *Interp) initGlobals(globals map[string]interface{}) (pkgs []*ssa.Package, links []*load.LinkSym) {
+	for _, pkg := range i.mainpkg.Prog.AllPackages() {
 		// skip external pkg
 		if pkg.Func("init").Blocks == nil {
 			continue
@@ -1174,7 +1462,8 @@ func newInterp(ctx *Context, mainpkg *ssa.Package, globals map[string]interface{
 			case *ssa.Global:
 				typ := i.preToType(deref(v.Type()))
 				key := v.String()
-				if ext, ok := findExternValue(i, key); ok && ext.Kind() == reflect.Ptr && ext.Elem().Type() == typ {
+				// check extern value or var of register source package
+				if ext, ok := findExternVar(i, pkg.Pkg.Path(), v.Name()); ok && ext.Kind() == reflect.Ptr && ext.Elem().Type() == typ {
 					i.globals[key] = ext.Interface()
 					i.chkinit[key] = true
 				} else {
@@ -1184,7 +1473,6 @@ func newInterp(ctx *Context, mainpkg *ssa.Package, globals map[string]interface{
 		}
 	}
 	// check linkname var
-	var links []*load.LinkSym
 	for _, sp := range i.ctx.pkgs {
 		for _, link := range sp.Links {
 			if link.Kind == ast.Var {
@@ -1210,19 +1498,7 @@ func newInterp(ctx *Context, mainpkg *ssa.Package, globals map[string]interface{
 			}
 		}
 	}
-	// static types check
-	err := checkPackages(i, pkgs)
-	if err != nil {
-		return i, err
-	}
-	// check linkname duplicated definition
-	for _, link := range links {
-		localName, targetName := link.PkgPath+"."+link.Name, link.Linkname.PkgPath+"."+link.Linkname.Name
-		if i.chkinit[localName] && i.chkinit[targetName] {
-			return i, fmt.Errorf("duplicated definition of symbol %v, from %v and %v", targetName, link.PkgPath, link.Linkname.PkgPath)
-		}
-	}
-	return i, err
+	return
 }
 
 func (i *Interp) loadType(typ types.Type) {
@@ -1247,25 +1523,44 @@ func (i *Interp) preToType(typ types.Type) reflect.Type {
 }
 
 func (i *Interp) toType(typ types.Type) reflect.Type {
-	if t, ok := i.preloadTypes[typ]; ok {
+	if p := i.program; p != nil && p.interp != i {
+		return p.interp.toType(typ)
+	}
+	// lock for preloadTypes, it is changed by compile on first call
+	i.typesMutex.RLock()
+	t, ok := i.preloadTypes[typ]
+	i.typesMutex.RUnlock()
+	if ok {
 		return t
 	}
-	// log.Panicf("toType %v %p\n", typ, typ)
 	i.typesMutex.Lock()
 	defer i.typesMutex.Unlock()
-	rt, _ := i.record.ToType(typ)
-	return rt
+	return i.preToType(typ)
 }
 
 func (i *Interp) RunFunc(name string, args ...Value) (r Value, err error) {
+	fn := i.mainpkg.Func(name)
+	if fn == nil {
+		return nil, fmt.Errorf("no function %v", name)
+	}
+	return i.runFrame(func(fr *frame) value {
+		return i.call(fr, fn, args, nil)
+	})
+}
+
+// runFrame run the call by entry frame of goroutine, return the panic of
+// call as error.
+func (i *Interp) runFrame(call func(fr *frame) value) (r Value, err error) {
 	fr := &frame{interp: i}
 	defer func() {
-		if i.ctx.Mode&DisableRecover != 0 {
+		if i.conf.mode&DisableRecover != 0 {
 			return
 		}
 		switch p := recover().(type) {
 		case nil:
 			// nothing
+		case *Continuation:
+			err = p.suspended(fr)
 		case exitPanic:
 			i.exitCode = int(p)
 			atomic.StoreInt32(&i.exited, 1)
@@ -1289,10 +1584,18 @@ func (i *Interp) RunFunc(name string, args ...Value) (r Value, err error) {
 			err = PanicError{stack: debugStack(pfr), Value: p}
 		}
 	}()
-	if fn := i.mainpkg.Func(name); fn != nil {
-		r = i.call(fr, fn, args, nil)
-	} else {
-		err = fmt.Errorf("no function %v", name)
+	if p := i.program; p != nil {
+		defer p.running.Delete(p.enter(i))
+	}
+	if s := i.sched; s != nil {
+		defer s.exit(s.enter())
+	}
+	if d := i.deadlock; d != nil {
+		defer d.goids.Delete(d.enter())
+	}
+	r = call(fr)
+	if d := i.deadlock; d != nil && d.err != nil {
+		err = d.err
 	}
 	return
 }
@@ -1342,8 +1645,147 @@ func (i *Interp) UnsafeRelease() {
 	i.record = nil
 }
 
+// Abort stop the interp, the blocking channel operations and time.Sleep of
+// all goroutines return immediately, the sync.WaitGroup.Wait return if run
+// with RunContext or CheckDeadlock mode.
 func (i *Interp) Abort() {
 	atomic.StoreInt32(&i.exited, 1)
+	i.abortOnce.Do(func() {
+		close(i.chabort)
+	})
+}
+
+// chanRecv receive from ch, return zero value if interp aborted.
+func (i *Interp) chanRecv(fr *frame, ch reflect.Value) (reflect.Value, bool) {
+	if g := i.sched.current(); g != nil {
+		chosen, v, ok := i.schedSelect(fr, g, []reflect.SelectCase{{Dir: reflect.SelectRecv, Chan: ch}},
+			chanWaitReason(ch, waitReasonChanReceive))
+		if chosen == -1 {
+			return reflect.New(ch.Type().Elem()).Elem(), false
+		}
+		return v, ok
+	}
+	if v, ok := ch.TryRecv(); ok || v.IsValid() {
+		return v, ok
+	}
+	if d := i.deadlock; d != nil {
+		defer d.unblock(d.block(fr, chanWaitReason(ch, waitReasonChanReceive), d.isLocalChan(ch)))
+	}
+	if l := i.leak; l != nil {
+		defer l.unblock(l.block(fr, chanWaitReason(ch, waitReasonChanReceive)))
+	}
+	if c := i.clock; c != nil {
+		defer c.unblock(c.block())
+	}
+	chosen, v, ok := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: ch},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(i.chabort)},
+	})
+	if chosen == 1 {
+		return reflect.New(ch.Type().Elem()).Elem(), false
+	}
+	return v, ok
+}
+
+// chanSend send x to ch, return false if interp aborted.
+func (i *Interp) chanSend(fr *frame, ch reflect.Value, x reflect.Value) bool {
+	if g := i.sched.current(); g != nil {
+		chosen, _, _ := i.schedSelect(fr, g, []reflect.SelectCase{{Dir: reflect.SelectSend, Chan: ch, Send: x}},
+			chanWaitReason(ch, waitReasonChanSend))
+		return chosen == 0
+	}
+	if ch.TrySend(x) {
+		return true
+	}
+	if d := i.deadlock; d != nil {
+		defer d.unblock(d.block(fr, chanWaitReason(ch, waitReasonChanSend), d.isLocalChan(ch)))
+	}
+	if l := i.leak; l != nil {
+		defer l.unblock(l.block(fr, chanWaitReason(ch, waitReasonChanSend)))
+	}
+	if c := i.clock; c != nil {
+		defer c.unblock(c.block())
+	}
+	chosen, _, _ := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectSend, Chan: ch, Send: x},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(i.chabort)},
+	})
+	return chosen == 0
+}
+
+// chanSelect is reflect.Select for blocking select, return -1 if interp aborted.
+func (i *Interp) chanSelect(fr *frame, cases []reflect.SelectCase) (int, reflect.Value, bool) {
+	reason := waitReasonSelect
+	if len(cases) == 0 {
+		reason = waitReasonSelectNoCases
+	}
+	if g := i.sched.current(); g != nil {
+		return i.schedSelect(fr, g, cases, reason)
+	}
+	if d := i.deadlock; d != nil {
+		// try select without blocking first
+		chosen, recv, recvOk := reflect.Select(append(cases, reflect.SelectCase{Dir: reflect.SelectDefault}))
+		if chosen < len(cases) {
+			return chosen, recv, recvOk
+		}
+		defer d.unblock(d.block(fr, reason, d.isLocalCases(cases)))
+	}
+	if l := i.leak; l != nil {
+		defer l.unblock(l.block(fr, reason))
+	}
+	if c := i.clock; c != nil {
+		defer c.unblock(c.block())
+	}
+	cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(i.chabort)})
+	chosen, recv, recvOk := reflect.Select(cases)
+	if chosen == len(cases)-1 {
+		return -1, recv, false
+	}
+	return chosen, recv, recvOk
+}
+
+// schedSelect is blocking select of goroutine g by deterministic schedule,
+// return -1 if interp aborted.
+func (i *Interp) schedSelect(fr *frame, g *schedG, cases []reflect.SelectCase, reason string) (int, reflect.Value, bool) {
+	i.sched.yield(g)
+	if chosen, recv, recvOk := i.sched.trySelect(g, cases); chosen >= 0 {
+		return chosen, recv, recvOk
+	}
+	if d := i.deadlock; d != nil {
+		defer d.unblock(d.block(fr, reason, d.isLocalCases(cases)))
+	}
+	if l := i.leak; l != nil {
+		defer l.unblock(l.block(fr, reason))
+	}
+	if c := i.clock; c != nil {
+		defer c.unblock(c.block())
+	}
+	return i.sched.selectWait(g, cases)
+}
+
+// sleep pauses the goroutine for duration d, return false if interp aborted.
+func (i *Interp) sleep(fr *frame, d time.Duration) bool {
+	if d <= 0 {
+		return true
+	}
+	if l := i.leak; l != nil {
+		defer l.unblock(l.block(fr, waitReasonSleep))
+	}
+	if g := i.sched.current(); g != nil {
+		deadline := time.Now().Add(d)
+		i.sched.yield(g)
+		return i.sched.wait(g, func() bool {
+			return !time.Now().Before(deadline)
+		})
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return true
+	case <-i.chabort:
+		return false
+	}
 }
 
 func (i *Interp) RunMain() (exitCode int, err error) {
@@ -1351,12 +1793,20 @@ func (i *Interp) RunMain() (exitCode int, err error) {
 		return i.exitCode, nil
 	}
 	_, err = i.RunFunc("main")
+	if l := i.leak; l != nil && err == nil {
+		err = l.check()
+	}
 	if err != nil {
 		exitCode = 2
-	}
-	if atomic.LoadInt32(&i.exited) == 1 {
+	} else if atomic.LoadInt32(&i.exited) == 1 {
 		exitCode = i.exitCode
 	}
+	if r := i.race; r != nil && r.found() > 0 {
+		fmt.Fprintf(r.output, "Found %v data race(s)\n", r.found())
+		if exitCode == 0 {
+			exitCode = raceExitCode
+		}
+	}
 	return
 }
 
@@ -0,0 +1,186 @@
+//go:build go1.22
+// +build go1.22
+
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/goplus/igop"
+	_ "github.com/goplus/igop/pkg/cmp"
+	_ "github.com/goplus/igop/pkg/math/rand/v2"
+	_ "github.com/goplus/igop/pkg/reflect"
+	_ "github.com/goplus/igop/pkg/slices"
+	_ "github.com/goplus/igop/pkg/time"
+)
+
+func TestRangeInt(t *testing.T) {
+	src := `package main
+
+func main() {
+	var sum int
+	for i := range 10 {
+		sum += i
+	}
+	if sum != 45 {
+		panic(sum)
+	}
+	var n int
+	for range uint8(3) {
+		n++
+	}
+	if n != 3 {
+		panic(n)
+	}
+}
+`
+	_, err := igop.RunFile("main.go", src, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+var loopVarSrc = `package main
+
+import "fmt"
+
+func main() {
+	var fns []func() int
+	for i := 0; i < 3; i++ {
+		fns = append(fns, func() int { return i })
+	}
+	var s string
+	for _, fn := range fns {
+		s += fmt.Sprint(fn())
+	}
+	if s != "$WANT" {
+		panic(s)
+	}
+}
+`
+
+func TestLoopVarGoVersion(t *testing.T) {
+	for _, v := range []struct {
+		version string
+		want    string
+	}{
+		{"1.21", "333"},
+		{"1.22", "012"},
+	} {
+		dir, err := os.MkdirTemp("", "igop_loopvar")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module loopvar\n\ngo "+v.version+"\n"), 0644)
+		os.WriteFile(filepath.Join(dir, "main.go"), []byte(strings.Replace(loopVarSrc, "$WANT", v.want, 1)), 0644)
+		ctx := igop.NewContext(0)
+		pkg, err := ctx.LoadDir(dir, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		interp, err := ctx.NewInterp(pkg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ctx.RunInterp(interp, dir, nil); err != nil {
+			t.Fatalf("go %v: %v", v.version, err)
+		}
+	}
+}
+
+func TestLoopVarFileVersion(t *testing.T) {
+	src := "//go:build go1.21\n\n" + strings.Replace(loopVarSrc, "$WANT", "333", 1)
+	_, err := igop.RunFile("main.go", src, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src = strings.Replace(loopVarSrc, "$WANT", "012", 1)
+	_, err = igop.RunFile("main.go", src, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGo122Packages(t *testing.T) {
+	src := `package main
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+)
+
+func main() {
+	s := slices.Concat([]int{1, 2}, []int{3}, nil, []int{4, 5})
+	if !slices.Equal(s, []int{1, 2, 3, 4, 5}) {
+		panic(s)
+	}
+	if v := cmp.Or("", "a", "b"); v != "a" {
+		panic(v)
+	}
+	r := rand.New(rand.NewPCG(1, 2))
+	if n := r.IntN(10); n < 0 || n >= 10 {
+		panic(n)
+	}
+	if n := rand.Int64N(100); n < 0 || n >= 100 {
+		panic(n)
+	}
+}
+`
+	_, err := igop.RunFile("main.go", src, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGo122GenericFuncs(t *testing.T) {
+	src := `package main
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"time"
+)
+
+type T struct{}
+
+func main() {
+	if n := rand.N[int](10); n < 0 || n >= 10 {
+		panic(n)
+	}
+	if d := rand.N(time.Second); d < 0 || d >= time.Second {
+		panic(d)
+	}
+	if s := reflect.TypeFor[T]().String(); s != "main.T" {
+		panic(s)
+	}
+	if k := reflect.TypeFor[error]().Kind(); k != reflect.Interface {
+		panic(k)
+	}
+}
+`
+	_, err := igop.RunFile("main.go", src, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,373 @@
+//go:build go1.23
+// +build go1.23
+
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop_test
+
+import (
+	"testing"
+
+	"github.com/goplus/igop"
+	_ "github.com/goplus/igop/pkg/fmt"
+	_ "github.com/goplus/igop/pkg/iter"
+	_ "github.com/goplus/igop/pkg/maps"
+	_ "github.com/goplus/igop/pkg/slices"
+	_ "github.com/goplus/igop/pkg/sort"
+)
+
+func TestRangeFunc(t *testing.T) {
+	src := `package main
+
+func seq(n int) func(func(int) bool) {
+	return func(yield func(int) bool) {
+		for i := 0; i < n; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func pairs(yield func(string, int) bool) {
+	_ = yield("a", 1) && yield("b", 2) && yield("c", 3)
+}
+
+func find(n int) int {
+	for i := range seq(10) {
+		if i == n {
+			return i * 10
+		}
+	}
+	return -1
+}
+
+func nested() int {
+	for i := range seq(5) {
+		for j := range seq(5) {
+			if i+j == 5 {
+				return i*10 + j
+			}
+		}
+	}
+	return -1
+}
+
+func gotoOut() int {
+	for i := range seq(10) {
+		if i == 3 {
+			goto done
+		}
+	}
+	return -1
+done:
+	return 3
+}
+
+func main() {
+	var s []int
+	for i := range seq(10) {
+		if i == 3 {
+			break
+		}
+		s = append(s, i)
+	}
+	if len(s) != 3 || s[2] != 2 {
+		panic(s)
+	}
+	var keys string
+	var sum int
+	for k, v := range pairs {
+		keys += k
+		sum += v
+	}
+	if keys != "abc" || sum != 6 {
+		panic(keys)
+	}
+	if v := find(4); v != 40 {
+		panic(v)
+	}
+	if v := nested(); v != 14 {
+		panic(v)
+	}
+	if v := gotoOut(); v != 3 {
+		panic(v)
+	}
+	var n int
+outer:
+	for i := range seq(3) {
+		for j := range seq(3) {
+			if j == 2 {
+				continue outer
+			}
+			if i == 2 {
+				break outer
+			}
+			n++
+		}
+	}
+	if n != 4 {
+		panic(n)
+	}
+}
+`
+	_, err := igop.RunFile("main.go", src, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRangeFuncDefer(t *testing.T) {
+	src := `package main
+
+import "fmt"
+
+var log string
+
+func seq(n int) func(func(int) bool) {
+	return func(yield func(int) bool) {
+		defer func() { log += "done;" }()
+		for i := 0; i < n; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func deferIn() (r string) {
+	for i := range seq(3) {
+		defer func() { r += fmt.Sprint(i) }()
+	}
+	r = "x"
+	return
+}
+
+func deferReturn() (r int) {
+	defer func() { r *= 2 }()
+	for i := range seq(5) {
+		defer func() { r += i }()
+		if i == 2 {
+			return 100
+		}
+	}
+	return -1
+}
+
+func main() {
+	if r := deferIn(); r != "x210" {
+		panic(r)
+	}
+	if r := deferReturn(); r != 206 {
+		panic(r)
+	}
+	if log != "done;done;" {
+		panic(log)
+	}
+}
+`
+	_, err := igop.RunFile("main.go", src, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRangeFuncPanic(t *testing.T) {
+	src := `package main
+
+import "fmt"
+
+var log string
+
+func seq(n int) func(func(int) bool) {
+	return func(yield func(int) bool) {
+		defer func() { log += "done;" }()
+		for i := 0; i < n; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func bad(yield func(int) bool) {
+	yield(1)
+	yield(2)
+}
+
+func swallow(yield func(int) bool) {
+	defer func() { recover() }()
+	yield(1)
+}
+
+func panicIn() (err any) {
+	defer func() { err = recover() }()
+	for i := range seq(3) {
+		if i == 1 {
+			panic("boom")
+		}
+	}
+	return nil
+}
+
+func recoverIn() (s string) {
+	for i := range seq(3) {
+		func() {
+			defer func() {
+				if e := recover(); e != nil {
+					s += fmt.Sprint(e)
+				}
+			}()
+			if i == 1 {
+				panic("p")
+			}
+			s += fmt.Sprint(i)
+		}()
+	}
+	return
+}
+
+func check(fn func(), want string) {
+	defer func() {
+		r := recover()
+		if err, ok := r.(error); !ok || err.Error() != want {
+			panic(fmt.Sprintf("got %v, want %v", r, want))
+		}
+	}()
+	fn()
+}
+
+func main() {
+	if err := panicIn(); err != "boom" || log != "done;" {
+		panic(fmt.Sprint(err, log))
+	}
+	if s := recoverIn(); s != "0p2" {
+		panic(s)
+	}
+	check(func() {
+		for range bad {
+			break
+		}
+	}, "runtime error: range function continued iteration after function for loop body returned false")
+	check(func() {
+		for range swallow {
+			panic("inner")
+		}
+	}, "runtime error: range function recovered a loop body panic and did not resume panicking")
+}
+`
+	_, err := igop.RunFile("main.go", src, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIterPackages(t *testing.T) {
+	src := `package main
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"sort"
+)
+
+func count(n int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := range n {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func main() {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	keys := slices.Collect(maps.Keys(m))
+	sort.Strings(keys)
+	if !slices.Equal(keys, []string{"a", "b", "c"}) {
+		panic(keys)
+	}
+	values := slices.Collect(maps.Values(m))
+	sort.Ints(values)
+	if !slices.Equal(values, []int{1, 2, 3}) {
+		panic(values)
+	}
+	var sum int
+	for i, v := range slices.All([]int{10, 20, 30}) {
+		sum += i * v
+	}
+	if sum != 80 {
+		panic(sum)
+	}
+	var back []int
+	for i := range slices.Backward([]int{1, 2, 3}) {
+		back = append(back, i)
+	}
+	if !slices.Equal(back, []int{2, 1, 0}) {
+		panic(back)
+	}
+	if s := slices.Collect(count(4)); !slices.Equal(s, []int{0, 1, 2, 3}) {
+		panic(s)
+	}
+	if m := maps.Collect(slices.All([]string{"p", "q"})); len(m) != 2 || m[1] != "q" {
+		panic(m)
+	}
+	var chunks int
+	for c := range slices.Chunk([]int{1, 2, 3, 4, 5}, 2) {
+		chunks += len(c) * 10
+	}
+	if chunks != 50 {
+		panic(chunks)
+	}
+	if s := slices.Repeat([]int{1, 2}, 2); !slices.Equal(s, []int{1, 2, 1, 2}) {
+		panic(s)
+	}
+	next, stop := iter.Pull(count(3))
+	var pulled []int
+	for {
+		v, ok := next()
+		if !ok {
+			break
+		}
+		pulled = append(pulled, v)
+	}
+	stop()
+	if !slices.Equal(pulled, []int{0, 1, 2}) {
+		panic(pulled)
+	}
+	next, stop = iter.Pull(count(10))
+	if v, ok := next(); v != 0 || !ok {
+		panic(v)
+	}
+	stop()
+	if _, ok := next(); ok {
+		panic("next after stop")
+	}
+	next2, stop2 := iter.Pull2(maps.All(map[int]string{1: "one"}))
+	if k, v, ok := next2(); k != 1 || v != "one" || !ok {
+		panic(v)
+	}
+	stop2()
+}
+`
+	_, err := igop.RunFile("main.go", src, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -18,13 +18,23 @@ package igop_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
 	"log"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"runtime/debug"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -33,14 +43,17 @@ import (
 
 	_ "github.com/goplus/igop/pkg/bytes"
 	_ "github.com/goplus/igop/pkg/errors"
+	_ "github.com/goplus/igop/pkg/flag"
 	_ "github.com/goplus/igop/pkg/fmt"
 	_ "github.com/goplus/igop/pkg/math"
+	_ "github.com/goplus/igop/pkg/math/rand"
 	_ "github.com/goplus/igop/pkg/os"
 	_ "github.com/goplus/igop/pkg/path/filepath"
 	_ "github.com/goplus/igop/pkg/reflect"
 	_ "github.com/goplus/igop/pkg/runtime"
 	_ "github.com/goplus/igop/pkg/strings"
 	_ "github.com/goplus/igop/pkg/sync"
+	_ "github.com/goplus/igop/pkg/testing"
 	_ "github.com/goplus/igop/pkg/time"
 )
 
@@ -2405,6 +2418,225 @@ func check(info info.Info) {
 	}
 }
 
+func TestRegisterPackageLoader(t *testing.T) {
+	var loaded int
+	igop.RegisterPackageLoader("github.com/goplus/igop/testdata/lazy", func() *igop.Package {
+		loaded++
+		return &igop.Package{
+			Name:       "lazy",
+			Path:       "github.com/goplus/igop/testdata/lazy",
+			Deps:       map[string]string{},
+			Interfaces: map[string]reflect.Type{},
+			NamedTypes: map[string]reflect.Type{},
+			AliasTypes: map[string]reflect.Type{},
+			Vars:       map[string]reflect.Value{},
+			Funcs: map[string]reflect.Value{
+				"Add": reflect.ValueOf(func(a, b int) int { return a + b }),
+			},
+			TypedConsts:   map[string]igop.TypedConst{},
+			UntypedConsts: map[string]igop.UntypedConst{},
+		}
+	})
+	var found bool
+	for _, path := range igop.PackageList() {
+		if path == "github.com/goplus/igop/testdata/lazy" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("lazy package not in package list")
+	}
+	if loaded != 0 {
+		t.Fatal("lazy package loaded before import")
+	}
+	src := `package main
+
+import "github.com/goplus/igop/testdata/lazy"
+
+func main() {
+	if lazy.Add(100, 200) != 300 {
+		panic("error lazy.Add")
+	}
+}
+`
+	for i := 0; i < 2; i++ {
+		_, err := igop.RunFile("main.go", src, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if loaded != 1 {
+		t.Fatalf("lazy package loaded %v times", loaded)
+	}
+}
+
+func TestPackageInfo(t *testing.T) {
+	info, ok := igop.LookupPackageInfo("strings")
+	if !ok {
+		t.Fatal("not found strings info")
+	}
+	if info.Name != "strings" || info.Path != "strings" {
+		t.Fatalf("bad info %v %v", info.Name, info.Path)
+	}
+	sym, ok := info.Lookup("Builder")
+	if !ok || sym.Kind != igop.SymbolType {
+		t.Fatal("not found strings.Builder")
+	}
+	var found bool
+	for _, m := range sym.Methods {
+		if m.Name == "WriteString" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("not found (*strings.Builder).WriteString")
+	}
+	sym, ok = info.Lookup("Contains")
+	if !ok || sym.Kind != igop.SymbolFunc {
+		t.Fatal("not found strings.Contains")
+	}
+	if s := sym.String(); s != "func strings.Contains(string, string) bool" {
+		t.Fatalf("bad func %v", s)
+	}
+	info, _ = igop.LookupPackageInfo("math")
+	sym, ok = info.Lookup("MaxInt8")
+	if !ok || sym.Kind != igop.SymbolConst || sym.Value.String() != "127" {
+		t.Fatal("bad math.MaxInt8")
+	}
+	if _, ok := igop.LookupPackageInfo("github.com/goplus/igop/notfound"); ok {
+		t.Fatal("must not found package")
+	}
+	if pkg, sym, method, ok := igop.LookupSymbol("strings.Builder.WriteString"); !ok || pkg != "strings" || sym != "Builder" || method != "WriteString" {
+		t.Fatalf("bad symbol %v %v %v %v", pkg, sym, method, ok)
+	}
+	if pkg, sym, _, ok := igop.LookupSymbol("path/filepath.Join"); !ok || pkg != "path/filepath" || sym != "Join" {
+		t.Fatalf("bad symbol %v %v %v", pkg, sym, ok)
+	}
+	igop.RegisterPackage(&igop.Package{
+		Name: "info",
+		Path: "github.com/goplus/igop/testdata/info",
+		NamedTypes: map[string]reflect.Type{
+			"Field": reflect.TypeOf((*infoField)(nil)).Elem(),
+		},
+	})
+	info, _ = igop.LookupPackageInfo("github.com/goplus/igop/testdata/info")
+	sym, _ = info.Lookup("Field")
+	if s := sym.String(); !strings.Contains(s, "struct{\n    A int\n    // ... other fields elided ...\n    C string\n}") {
+		t.Fatalf("bad struct %q", s)
+	}
+}
+
+type infoField struct {
+	A int
+	b int
+	c int
+	C string
+}
+
+func TestDiffPackage(t *testing.T) {
+	src := `package diff
+
+const Max = 10
+
+var Data []byte
+
+func Add(a, b int) int
+
+func Sub(a, b int) int
+
+type T struct{}
+
+func (T) M() {}
+func (*T) N() {}
+
+type Size = int
+
+type Bytes = []byte
+`
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "diff.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tpkg, err := new(types.Config).Check("example.com/diff", fset, []*ast.File{f}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg := &igop.Package{
+		Name:       "diff",
+		Path:       "example.com/diff",
+		Interfaces: map[string]reflect.Type{},
+		NamedTypes: map[string]reflect.Type{
+			"T": reflect.TypeOf((*struct{})(nil)).Elem(),
+		},
+		AliasTypes: map[string]reflect.Type{
+			"Size":  reflect.TypeOf(0),
+			"Bytes": reflect.TypeOf(""),
+		},
+		Vars: map[string]reflect.Value{
+			"Data": reflect.ValueOf(new([]uint8)),
+		},
+		Funcs: map[string]reflect.Value{
+			"Add": reflect.ValueOf(func(a, b int) int { return a + b }),
+			"Sub": reflect.ValueOf(func(a, b int64) int64 { return a - b }),
+			"Old": reflect.ValueOf(func() {}),
+		},
+		TypedConsts: map[string]igop.TypedConst{},
+		UntypedConsts: map[string]igop.UntypedConst{
+			"Max": {"untyped int", constant.MakeInt64(20)},
+		},
+	}
+	d := igop.DiffPackage(pkg, tpkg)
+	want := `missing method T.M
+missing method T.N
+extra Old
+changed Bytes type: register string, want []uint8
+changed Max value: register 20, want 10
+changed Sub type: register func(int64, int64) int64, want func(int, int) int
+`
+	if d.String() != want {
+		t.Fatalf("bad diff:\n%v", d)
+	}
+}
+
+func TestMissingImport(t *testing.T) {
+	src := `package main
+
+import "example.com/missing"
+
+func main() {
+	if missing.Value() != 100 {
+		panic("error missing.Value")
+	}
+}
+`
+	ctx := igop.NewContext(0)
+	ctx.Missing = func(root, path string) (string, bool) {
+		if path != "example.com/missing" {
+			return "", false
+		}
+		igop.RegisterPackage(&igop.Package{
+			Name:       "missing",
+			Path:       path,
+			Deps:       map[string]string{},
+			Interfaces: map[string]reflect.Type{},
+			NamedTypes: map[string]reflect.Type{},
+			AliasTypes: map[string]reflect.Type{},
+			Vars:       map[string]reflect.Value{},
+			Funcs: map[string]reflect.Value{
+				"Value": reflect.ValueOf(func() int { return 100 }),
+			},
+			TypedConsts:   map[string]igop.TypedConst{},
+			UntypedConsts: map[string]igop.UntypedConst{},
+		})
+		return "", true
+	}
+	_, err := ctx.RunFile("main.go", src, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestBuildTags(t *testing.T) {
 	if runtime.GOOS == "darwin" {
 		switch runtime.Version()[:6] {
@@ -2629,7 +2861,9 @@ func main() {
 }
 `
 	ctx := igop.NewContext(0)
-	ctx.RunContext, _ = context.WithTimeout(context.Background(), 1e9)
+	var cancel context.CancelFunc
+	ctx.RunContext, cancel = context.WithTimeout(context.Background(), 1e9)
+	defer cancel()
 	code, err := ctx.RunFile("main.go", src, nil)
 	if code != 2 {
 		t.Fatalf("exit code must 2")
@@ -2637,51 +2871,1963 @@ func main() {
 	t.Log("cancel context:", err)
 }
 
-func TestReflectArray(t *testing.T) {
-	var src = `package main
+func TestRunContextAbort(t *testing.T) {
+	var src string = `
+package main
 
-import (
-	"reflect"
-	"fmt"
-)
+import "time"
 
-type IntArray [2]int
+func main() {
+	ch := make(chan int)
+	go func() {
+		ch <- 1
+	}()
+	go func() {
+		var c chan int
+		<-c
+	}()
+	go func() {
+		select {
+		case <-ch:
+		case ch <- 2:
+		}
+	}()
+	go func() {
+		time.Sleep(time.Hour)
+	}()
+	select {}
+}
+`
+	n := runtime.NumGoroutine()
+	ctx := igop.NewContext(0)
+	var cancel context.CancelFunc
+	ctx.RunContext, cancel = context.WithTimeout(context.Background(), 1e8)
+	defer cancel()
+	start := time.Now()
+	code, err := ctx.RunFile("main.go", src, nil)
+	if code != 2 || err != context.DeadlineExceeded {
+		t.Fatalf("must abort by context: %v %v", code, err)
+	}
+	if d := time.Since(start); d > 5e8 {
+		t.Fatalf("abort timeout %v", d)
+	}
+	for i := 0; i < 50 && runtime.NumGoroutine() > n; i++ {
+		time.Sleep(1e7)
+	}
+	if m := runtime.NumGoroutine(); m > n {
+		t.Fatalf("goroutines leak %v", m-n)
+	}
+}
 
-func (i IntArray) String() string {
-	return fmt.Sprintf("(%v,%v)", i[0], i[1])
+func TestRunContextAbortWaitGroup(t *testing.T) {
+	var src string = `
+package main
+
+import "sync"
+
+func main() {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		wg.Wait()
+	}()
+	wg.Wait()
+}
+`
+	n := runtime.NumGoroutine()
+	ctx := igop.NewContext(0)
+	var cancel context.CancelFunc
+	ctx.RunContext, cancel = context.WithTimeout(context.Background(), 1e8)
+	defer cancel()
+	start := time.Now()
+	code, err := ctx.RunFile("main.go", src, nil)
+	if code != 2 || err != context.DeadlineExceeded {
+		t.Fatalf("must abort by context: %v %v", code, err)
+	}
+	if d := time.Since(start); d > 5e8 {
+		t.Fatalf("abort timeout %v", d)
+	}
+	// the goroutines of two waits exit when the counter is zero
+	n += 2
+	for i := 0; i < 50 && runtime.NumGoroutine() > n; i++ {
+		time.Sleep(1e7)
+	}
+	if m := runtime.NumGoroutine(); m > n {
+		t.Fatalf("goroutines leak %v", m-n)
+	}
 }
 
-func (i *IntArray) Set(x, y int) {
-	*(*int)(&i[0]), *(*int)(&i[1]) = x, y
+func TestCheckDeadlock(t *testing.T) {
+	var src string = `
+package main
+
+import "sync"
+
+func main() {
+	ch := make(chan int)
+	go func() {
+		ch <- 1
+	}()
+	<-ch
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		println(<-ch)
+	}()
+	wg.Wait()
+}
+`
+	ctx := igop.NewContext(igop.CheckDeadlock)
+	code, err := ctx.RunFile("main.go", src, nil)
+	if code != 2 || !errors.Is(err, igop.ErrDeadlock) {
+		t.Fatalf("must deadlock: %v %v", code, err)
+	}
+	stack := string(err.(igop.DeadlockError).Stack())
+	if !strings.Contains(stack, "[sync.WaitGroup.Wait]:\nmain.main()") ||
+		!strings.Contains(stack, "[chan receive]:\nmain.main.func2()") {
+		t.Fatalf("bad deadlock stack:\n%v", stack)
+	}
 }
 
-func (i IntArray) Get() (int, int) {
-	return i[0], i[1]
+func TestCheckDeadlockTimer(t *testing.T) {
+	var src string = `
+package main
+
+import "time"
+
+func main() {
+	ch := make(chan int)
+	go func() {
+		<-ch
+	}()
+	<-time.After(3e8)
+	done := make(chan bool)
+	time.AfterFunc(1e8, func() {
+		close(done)
+	})
+	<-done
 }
 
-func (i IntArray) Scale(v int) IntArray {
-	return IntArray{i[0] * v, i[1] * v}
+`
+	ctx := igop.NewContext(igop.CheckDeadlock)
+	code, err := ctx.RunFile("main.go", src, nil)
+	if code != 0 || err != nil {
+		t.Fatalf("must not deadlock: %v %v", code, err)
+	}
 }
 
+func TestCheckDataRace(t *testing.T) {
+	var src string = `
+package main
+
+var n int
+
 func main() {
-	var a IntArray
-	a.Set(100,200)
-	b := a.Scale(5)
-	if b[0] != 500 || b[1] != 1000 {
-		panic("error")
+	done := make(chan bool)
+	go func() {
+		n = 1
+		done <- true
+	}()
+	println(n)
+	<-done
+}
+`
+	ctx := igop.NewContext(igop.CheckDataRace)
+	code, err := ctx.RunFile("main.go", src, nil)
+	if err != nil {
+		t.Fatal(err)
 	}
-	typ := reflect.TypeOf((*IntArray)(nil)).Elem()
-	v := reflect.New(typ).Elem()
-	v.Addr().MethodByName("Set").Call([]reflect.Value{reflect.ValueOf(100),reflect.ValueOf(200)})
-	x := v.MethodByName("Scale").Call([]reflect.Value{reflect.ValueOf(5)})
-	if x[0].Index(0).Int() != 500 || x[0].Index(1).Int() != 1000 {
-		panic("error reflect")
+	if code != 66 {
+		t.Fatalf("must found data race: %v", code)
+	}
+}
+
+func TestCheckDataRaceSync(t *testing.T) {
+	var src string = `
+package main
+
+import "sync"
+
+var n int
+
+func main() {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	m := make(map[int]int)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			mu.Lock()
+			m[i] = i
+			n++
+			mu.Unlock()
+		}(i)
+	}
+	wg.Wait()
+	ch := make(chan int)
+	go func() {
+		n++
+		ch <- len(m)
+	}()
+	<-ch
+	if n != 5 {
+		panic(n)
 	}
 }
 `
-	ctx := igop.NewContext(0)
-	_, err := ctx.RunFile("main.go", src, nil)
+	ctx := igop.NewContext(igop.CheckDataRace)
+	code, err := ctx.RunFile("main.go", src, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
+	if code != 0 {
+		t.Fatalf("must no data race: %v", code)
+	}
+}
+
+func TestCheckDataRaceSyncSemantics(t *testing.T) {
+	for _, src := range []string{`
+package main
+
+import "sync"
+
+var n int
+
+func main() {
+	var mu sync.RWMutex
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mu.RLock()
+			n++
+			mu.RUnlock()
+		}()
+	}
+	wg.Wait()
+}
+`, `
+package main
+
+import "sync/atomic"
+
+var n int
+
+func main() {
+	var flag int32
+	done := make(chan bool)
+	go func() {
+		n = 1
+		atomic.LoadInt32(&flag)
+		done <- true
+	}()
+	atomic.LoadInt32(&flag)
+	println(n)
+	<-done
+}
+`} {
+		ctx := igop.NewContext(igop.CheckDataRace)
+		code, err := ctx.RunFile("main.go", src, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code != 66 {
+			t.Fatalf("must found data race: %v", code)
+		}
+	}
+	var src = `
+package main
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+var n int
+
+func main() {
+	var mu sync.RWMutex
+	var flag int32
+	done := make(chan bool)
+	go func() {
+		mu.Lock()
+		n++
+		mu.Unlock()
+		n++
+		atomic.StoreInt32(&flag, 1)
+		done <- true
+	}()
+	for atomic.LoadInt32(&flag) == 0 {
+	}
+	mu.RLock()
+	_ = n
+	mu.RUnlock()
+	<-done
+}
+`
+	ctx := igop.NewContext(igop.CheckDataRace)
+	code, err := ctx.RunFile("main.go", src, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code != 0 {
+		t.Fatalf("must no data race: %v", code)
+	}
+}
+
+func TestReflectArray(t *testing.T) {
+	var src = `package main
+
+import (
+	"reflect"
+	"fmt"
+)
+
+type IntArray [2]int
+
+func (i IntArray) String() string {
+	return fmt.Sprintf("(%v,%v)", i[0], i[1])
+}
+
+func (i *IntArray) Set(x, y int) {
+	*(*int)(&i[0]), *(*int)(&i[1]) = x, y
+}
+
+func (i IntArray) Get() (int, int) {
+	return i[0], i[1]
+}
+
+func (i IntArray) Scale(v int) IntArray {
+	return IntArray{i[0] * v, i[1] * v}
+}
+
+func main() {
+	var a IntArray
+	a.Set(100,200)
+	b := a.Scale(5)
+	if b[0] != 500 || b[1] != 1000 {
+		panic("error")
+	}
+	typ := reflect.TypeOf((*IntArray)(nil)).Elem()
+	v := reflect.New(typ).Elem()
+	v.Addr().MethodByName("Set").Call([]reflect.Value{reflect.ValueOf(100),reflect.ValueOf(200)})
+	x := v.MethodByName("Scale").Call([]reflect.Value{reflect.ValueOf(5)})
+	if x[0].Index(0).Int() != 500 || x[0].Index(1).Int() != 1000 {
+		panic("error reflect")
+	}
+}
+`
+	ctx := igop.NewContext(0)
+	_, err := ctx.RunFile("main.go", src, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckGoroutineLeak(t *testing.T) {
+	var src string = `
+package main
+
+import "time"
+
+func main() {
+	ch := make(chan int)
+	go func() {
+		<-ch
+	}()
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+	}()
+}
+`
+	ctx := igop.NewContext(igop.CheckGoroutineLeak)
+	code, err := ctx.RunFile("main.go", src, nil)
+	if code != 2 || !errors.Is(err, igop.ErrGoroutineLeak) {
+		t.Fatalf("must leak: %v %v", code, err)
+	}
+	stack := string(err.(igop.GoroutineLeakError).Stack())
+	if !strings.Contains(stack, "[chan receive]:\nmain.main.func1()") ||
+		!strings.Contains(stack, "created by main.main\n\tmain.go:8:2") ||
+		strings.Contains(stack, "main.main.func2()") {
+		t.Fatalf("bad leak stack:\n%v", stack)
+	}
+}
+
+func TestCheckGoroutineLeakRunning(t *testing.T) {
+	var src string = `
+package main
+
+import "sync"
+
+func lock(mu *sync.Mutex) {
+	mu.Lock()
+}
+
+func main() {
+	var mu sync.Mutex
+	mu.Lock()
+	go func() {
+		lock(&mu)
+	}()
+}
+`
+	ctx := igop.NewContext(igop.CheckGoroutineLeak)
+	code, err := ctx.RunFile("main.go", src, nil)
+	if code != 2 || !errors.Is(err, igop.ErrGoroutineLeak) {
+		t.Fatalf("must leak: %v %v", code, err)
+	}
+	stack := string(err.(igop.GoroutineLeakError).Stack())
+	if !strings.Contains(stack, "[running]:\nmain.lock()") ||
+		!strings.Contains(stack, "main.main.func1()") {
+		t.Fatalf("bad leak stack:\n%v", stack)
+	}
+}
+
+func TestCheckGoroutineLeakTest(t *testing.T) {
+	var src string = `
+package main
+
+import (
+	"flag"
+	"os"
+	"testing"
+)
+
+func TestLeak(t *testing.T) {
+	ch := make(chan int)
+	go func() {
+		<-ch
+	}()
+}
+
+func TestNoLeak(t *testing.T) {
+	done := make(chan bool)
+	go func() {
+		done <- true
+	}()
+	<-done
+}
+
+func main() {
+	flag.Parse()
+	match := func(pat, str string) (bool, error) { return true, nil }
+	if !testing.RunTests(match, []testing.InternalTest{{"TestLeak", TestLeak}, {"TestNoLeak", TestNoLeak}}) {
+		os.Exit(1)
+	}
+}
+`
+	stdout := os.Stdout
+	defer func() {
+		os.Stdout = stdout
+	}()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stdout = w
+	ctx := igop.NewContext(igop.CheckGoroutineLeak)
+	code, err := ctx.RunFile("main.go", src, nil)
+	w.Close()
+	os.Stdout = stdout
+	data, _ := io.ReadAll(r)
+	if code != 1 || err != nil {
+		t.Fatalf("must test failed: %v %v\n%s", code, err, data)
+	}
+	output := string(data)
+	if !strings.Contains(output, "--- FAIL: TestLeak") ||
+		strings.Contains(output, "--- FAIL: TestNoLeak") ||
+		!strings.Contains(output, "[chan receive]:") {
+		t.Fatalf("bad test output:\n%v", output)
+	}
+}
+
+func TestDeterministicSchedule(t *testing.T) {
+	var src string = `
+package main
+
+import "sync"
+
+func main() {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	ch := make(chan int)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 3; j++ {
+				mu.Lock()
+				print(i)
+				mu.Unlock()
+			}
+			ch <- i
+		}(i)
+	}
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+	for v := range ch {
+		print(v)
+	}
+	m := map[int]bool{1: true, 2: true, 3: true, 4: true, 5: true}
+	for k := range m {
+		print(k)
+	}
+}
+`
+	run := func(seed int64) string {
+		var buf bytes.Buffer
+		ctx := igop.NewContext(igop.DeterministicSchedule)
+		ctx.SetScheduleSeed(seed)
+		ctx.SetPrintOutput(&buf)
+		_, err := ctx.RunFile("main.go", src, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return buf.String()
+	}
+	outputs := make(map[string]bool)
+	for seed := int64(1); seed <= 5; seed++ {
+		out := run(seed)
+		for i := 0; i < 3; i++ {
+			if v := run(seed); v != out {
+				t.Fatalf("seed %v not reproducible: %v != %v", seed, v, out)
+			}
+		}
+		outputs[out] = true
+	}
+	if len(outputs) == 1 {
+		t.Fatal("same interleaving for different seeds")
+	}
+}
+
+func TestDeterministicScheduleCondTimer(t *testing.T) {
+	var src string = `
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+type T struct{ n int }
+
+func main() {
+	var mu sync.Mutex
+	cond := sync.NewCond(&mu)
+	var ready int
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			time.Sleep(time.Duration(i%2) * time.Second)
+			mu.Lock()
+			for ready == 0 {
+				cond.Wait()
+			}
+			print(i)
+			mu.Unlock()
+		}(i)
+	}
+	time.AfterFunc(time.Minute, func() {
+		mu.Lock()
+		ready = 1
+		cond.Broadcast()
+		mu.Unlock()
+	})
+	wg.Wait()
+	m := make(map[*T]bool)
+	for i := 0; i < 8; i++ {
+		m[&T{i}] = true
+	}
+	for k := range m {
+		print(k.n)
+	}
+}
+`
+	run := func(seed int64) string {
+		var buf bytes.Buffer
+		ctx := igop.NewContext(igop.DeterministicSchedule)
+		ctx.SetScheduleSeed(seed)
+		clock := igop.NewVirtualClock(time.Unix(0, 0))
+		clock.SetAutoAdvance(true)
+		ctx.SetVirtualClock(clock)
+		ctx.SetPrintOutput(&buf)
+		_, err := ctx.RunFile("main.go", src, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return buf.String()
+	}
+	for seed := int64(1); seed <= 3; seed++ {
+		out := run(seed)
+		for i := 0; i < 3; i++ {
+			if v := run(seed); v != out {
+				t.Fatalf("seed %v not reproducible: %v != %v", seed, v, out)
+			}
+		}
+	}
+}
+
+func TestVirtualClock(t *testing.T) {
+	var src string = `
+package main
+
+import "time"
+
+func main() {
+	start := time.Now()
+	timer := time.NewTimer(time.Minute)
+	if !timer.Stop() {
+		panic("stop")
+	}
+	done := make(chan bool)
+	go func() {
+		for i := 0; i < 10; i++ {
+			time.Sleep(time.Hour)
+		}
+		done <- true
+	}()
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	var ticks int
+	timeout := time.After(24 * time.Hour)
+	for {
+		select {
+		case <-done:
+			if d := time.Since(start); d != 10*time.Hour {
+				panic(d.String())
+			}
+			if ticks != 600 {
+				panic(ticks)
+			}
+			return
+		case <-ticker.C:
+			ticks++
+		case <-timeout:
+			panic("timeout")
+		}
+	}
+}
+`
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := igop.NewVirtualClock(start)
+	clock.SetAutoAdvance(true)
+	ctx := igop.NewContext(0)
+	ctx.SetVirtualClock(clock)
+	code, err := ctx.RunFile("main.go", src, nil)
+	if code != 0 || err != nil {
+		t.Fatalf("run error: %v %v", code, err)
+	}
+	if d := clock.Now().Sub(start); d != 10*time.Hour {
+		t.Fatalf("bad virtual time: %v", d)
+	}
+}
+
+func TestVirtualClockAdvance(t *testing.T) {
+	var src string = `
+package main
+
+import "time"
+
+func main() {
+	timer := time.NewTimer(2 * time.Hour)
+	<-timer.C
+}
+`
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := igop.NewVirtualClock(start)
+	ctx := igop.NewContext(0)
+	ctx.SetVirtualClock(clock)
+	done := make(chan error)
+	go func() {
+		_, err := ctx.RunFile("main.go", src, nil)
+		done <- err
+	}()
+	for !clock.AdvanceNext() {
+		time.Sleep(time.Millisecond)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if d := clock.Now().Sub(start); d != 2*time.Hour {
+		t.Fatalf("bad virtual time: %v", d)
+	}
+}
+
+func TestVirtualClockReset(t *testing.T) {
+	var src string = `
+package main
+
+import "time"
+
+func main() {
+	start := time.Now()
+	for i := 0; i < 1000; i++ {
+		time.Sleep(time.Second)
+	}
+	timer := time.NewTimer(time.Minute)
+	<-timer.C
+	if timer.Reset(time.Minute) {
+		panic("reset fired timer")
+	}
+	<-timer.C
+	timer.Reset(time.Minute)
+	if !timer.Stop() {
+		panic("stop")
+	}
+	timer.Reset(time.Hour)
+	<-timer.C
+	if d := time.Since(start); d != 1000*time.Second+2*time.Minute+time.Hour {
+		panic(d.String())
+	}
+}
+`
+	clock := igop.NewVirtualClock(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	clock.SetAutoAdvance(true)
+	ctx := igop.NewContext(0)
+	ctx.SetVirtualClock(clock)
+	_, err := ctx.RunFile("main.go", src, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRecordReplay(t *testing.T) {
+	var src string = `
+package main
+
+import (
+	"math/rand"
+	"os"
+	"time"
+)
+
+func main() {
+	f, err := os.Open(os.Getenv("IGOP_RECORD_FILE"))
+	if err != nil {
+		panic(err)
+	}
+	var r interface{ Read([]byte) (int, error) } = f
+	buf := make([]byte, 16)
+	n, _ := r.Read(buf)
+	f.Close()
+	println(string(buf[:n]))
+	ch := make(chan int64)
+	for i := 0; i < 3; i++ {
+		go func() {
+			ch <- rand.Int63n(1000)
+		}()
+	}
+	for i := 0; i < 3; i++ {
+		println(<-ch)
+	}
+	println(time.Now().UnixNano(), os.Getenv("IGOP_RECORD_TEST"), rand.Perm(5)[0])
+	if _, err := os.ReadFile("/igop/not/exist"); !os.IsNotExist(err) {
+		panic(err)
+	}
+}
+`
+	run := func(setup func(ctx *igop.Context)) string {
+		var buf bytes.Buffer
+		ctx := igop.NewContext(0)
+		ctx.SetScheduleSeed(time.Now().UnixNano())
+		setup(ctx)
+		ctx.SetPrintOutput(&buf)
+		_, err := ctx.RunFile("main.go", src, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return buf.String()
+	}
+	var trace bytes.Buffer
+	file := filepath.Join(t.TempDir(), "data")
+	os.WriteFile(file, []byte("file record"), 0644)
+	os.Setenv("IGOP_RECORD_FILE", file)
+	defer os.Unsetenv("IGOP_RECORD_FILE")
+	os.Setenv("IGOP_RECORD_TEST", "record")
+	defer os.Unsetenv("IGOP_RECORD_TEST")
+	out := run(func(ctx *igop.Context) {
+		ctx.SetRecord(&trace)
+	})
+	os.Setenv("IGOP_RECORD_TEST", "replay")
+	os.WriteFile(file, []byte("file replay"), 0644)
+	data := trace.Bytes()
+	for i := 0; i < 3; i++ {
+		v := run(func(ctx *igop.Context) {
+			if err := ctx.SetReplay(bytes.NewReader(data)); err != nil {
+				t.Fatal(err)
+			}
+		})
+		if v != out {
+			t.Fatalf("replay output %q, record %q", v, out)
+		}
+	}
+	if !strings.Contains(out, " record ") || !strings.HasPrefix(out, "file record\n") {
+		t.Fatalf("bad record output %q", out)
+	}
+}
+
+func TestMockPatch(t *testing.T) {
+	var src string = `
+package main
+
+import "strings"
+
+func Add(a, b int) int {
+	return a + b
+}
+
+func main() {
+	var sb strings.Builder
+	sb.WriteString("client")
+	println(Add(1, 2), sb.String(), strings.ToUpper("hello"))
+	var fn func(int, int) int = Add
+	println(fn(3, 4))
+}
+`
+	ctx := igop.NewContext(0)
+	ctx.Mock("main.Add", func(a, b int) int {
+		return a * b
+	})
+	ctx.Mock("(*strings.Builder).String", func(orig func(args ...interface{}) []interface{}, args ...interface{}) []interface{} {
+		args[0].(*strings.Builder).WriteString("-mock")
+		return []interface{}{strings.ToUpper(orig(args...)[0].(string))}
+	})
+	ctx.Mock("strings.ToUpper", func(s string) string {
+		return "<" + s + ">"
+	})
+	var buf bytes.Buffer
+	ctx.SetPrintOutput(&buf)
+	pkg, err := ctx.LoadFile("main.go", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	interp, err := ctx.NewInterp(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ctx.RunInterp(interp, "main.go", nil); err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != "2 CLIENT-MOCK <hello>\n12\n" {
+		t.Fatalf("bad mock output: %q", s)
+	}
+	restoreAdd, err := interp.Patch("main.Add", igop.PatchFunc(func(orig func(args ...interface{}) []interface{}, args ...interface{}) []interface{} {
+		return []interface{}{orig(args...)[0].(int) + 100}
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	restoreUpper, err := interp.Patch("strings.ToUpper", strings.ToUpper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	if _, err = ctx.RunInterp(interp, "main.go", nil); err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != "102 CLIENT-MOCK HELLO\n112\n" {
+		t.Fatalf("bad patch output: %q", s)
+	}
+	restoreAdd()
+	restoreUpper()
+	buf.Reset()
+	if _, err = ctx.RunInterp(interp, "main.go", nil); err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != "2 CLIENT-MOCK <hello>\n12\n" {
+		t.Fatalf("bad restore output: %q", s)
+	}
+	// restore out of order
+	addPatch := func(n int) func() {
+		restore, err := interp.Patch("main.Add", igop.PatchFunc(func(orig func(args ...interface{}) []interface{}, args ...interface{}) []interface{} {
+			return []interface{}{orig(args...)[0].(int) + n}
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return restore
+	}
+	restore100, restore1000 := addPatch(100), addPatch(1000)
+	restoreUpper1, _ := interp.Patch("strings.ToUpper", func(s string) string { return "1" + s })
+	restoreUpper2, _ := interp.Patch("strings.ToUpper", func(s string) string { return "2" + s })
+	restore100()
+	restoreUpper1()
+	buf.Reset()
+	if _, err = ctx.RunInterp(interp, "main.go", nil); err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != "1002 CLIENT-MOCK 2hello\n1012\n" {
+		t.Fatalf("bad restore out of order output: %q", s)
+	}
+	restore1000()
+	restore100()
+	restoreUpper2()
+	buf.Reset()
+	if _, err = ctx.RunInterp(interp, "main.go", nil); err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != "2 CLIENT-MOCK <hello>\n12\n" {
+		t.Fatalf("bad restore output: %q", s)
+	}
+	if _, err := interp.Patch("main.NotFound", func() {}); !errors.Is(err, igop.ErrNoFunction) {
+		t.Fatalf("patch not found: %v", err)
+	}
+	if _, err := interp.Patch("main.Add", func(a int) int { return a }); err == nil {
+		t.Fatal("patch bad signature must error")
+	}
+}
+
+func TestCallThunk(t *testing.T) {
+	var thunks int
+	igop.RegisterPackage(&igop.Package{
+		Name:       "thunk",
+		Path:       "github.com/goplus/igop/testdata/thunk",
+		Deps:       map[string]string{},
+		Interfaces: map[string]reflect.Type{},
+		NamedTypes: map[string]reflect.Type{},
+		AliasTypes: map[string]reflect.Type{},
+		Vars:       map[string]reflect.Value{},
+		Funcs: map[string]reflect.Value{
+			"Div":  reflect.ValueOf(func(a, b int) (int, int) { return a / b, a % b }),
+			"Join": reflect.ValueOf(func(sep string, s ...string) string { return strings.Join(s, sep) }),
+		},
+		Thunks: map[string]igop.CallThunk{
+			"Div": func(a, r []interface{}) {
+				thunks++
+				var a0, a1 int
+				if a[0] != nil {
+					a0 = a[0].(int)
+				}
+				if a[1] != nil {
+					a1 = a[1].(int)
+				}
+				r[0], r[1] = a0/a1, a0%a1
+			},
+			"Join": func(a, r []interface{}) {
+				thunks++
+				var a0 string
+				if a[0] != nil {
+					a0 = a[0].(string)
+				}
+				var a1 []string
+				if a[1] != nil {
+					a1 = a[1].([]string)
+				}
+				r[0] = strings.Join(a1, a0)
+			},
+		},
+		TypedConsts:   map[string]igop.TypedConst{},
+		UntypedConsts: map[string]igop.UntypedConst{},
+	})
+	src := `package main
+
+import "github.com/goplus/igop/testdata/thunk"
+
+func main() {
+	q, r := thunk.Div(7, 2)
+	println(q, r, thunk.Join(",", "a", "b"))
+}
+`
+	var buf bytes.Buffer
+	ctx := igop.NewContext(0)
+	ctx.SetPrintOutput(&buf)
+	if _, err := ctx.RunFile("main.go", src, nil); err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != "3 1 a,b\n" {
+		t.Fatalf("bad output %q", s)
+	}
+	if thunks != 2 {
+		t.Fatalf("call thunks %v, want 2", thunks)
+	}
+	// override func skip the thunk
+	thunks = 0
+	buf.Reset()
+	ctx = igop.NewContext(0)
+	ctx.SetPrintOutput(&buf)
+	ctx.RegisterExternal("github.com/goplus/igop/testdata/thunk.Div", func(a, b int) (int, int) {
+		return b, a
+	})
+	if _, err := ctx.RunFile("main.go", src, nil); err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != "2 7 a,b\n" || thunks != 1 {
+		t.Fatalf("bad override output %q, thunks %v", s, thunks)
+	}
+}
+
+func TestUnboxedRegisters(t *testing.T) {
+	var src string = `
+package main
+
+func main() {
+	var a int8 = 100
+	var b uint8 = 200
+	var c int32 = -1
+	var sum int
+	var f float32 = 0.1
+	var g float64
+	ok := false
+	for i := 0; i < 10; i++ {
+		a += 10
+		b += 10
+		c *= 3
+		sum += i &^ 1
+		f += 0.1
+		g += 0.25
+		ok = ok != (i%3 == 0)
+	}
+	println(a, b, c, sum, int64(f*1e6), int64(g*100), ok)
+	var u uint = 0
+	println(u-1 > 0, c < 0, a < -100, b > 100)
+}
+`
+	var buf bytes.Buffer
+	ctx := igop.NewContext(0)
+	ctx.SetPrintOutput(&buf)
+	if _, err := ctx.RunFile("main.go", src, nil); err != nil {
+		t.Fatal(err)
+	}
+	var a int8 = 100
+	var b uint8 = 200
+	var c int32 = -1
+	var sum int
+	var f float32 = 0.1
+	var g float64
+	ok := false
+	for i := 0; i < 10; i++ {
+		a += 10
+		b += 10
+		c *= 3
+		sum += i &^ 1
+		f += 0.1
+		g += 0.25
+		ok = ok != (i%3 == 0)
+	}
+	var u uint = 0
+	want := fmt.Sprintln(a, b, c, sum, int64(f*1e6), int64(g*100), ok) +
+		fmt.Sprintln(u-1 > 0, c < 0, a < -100, b > 100)
+	if s := buf.String(); s != want {
+		t.Fatalf("bad output %q, want %q", s, want)
+	}
+}
+
+func BenchmarkUnboxedLoop(b *testing.B) {
+	var src string = `
+package main
+
+func Loop() int {
+	var sum int
+	var f float64
+	for i := 0; i < 10000; i++ {
+		if i&1 == 0 {
+			sum += i * 3
+		}
+		f += 0.5
+	}
+	return sum + int(f)
+}
+
+func main() {
+}
+`
+	ctx := igop.NewContext(0)
+	pkg, err := ctx.LoadFile("main.go", src)
+	if err != nil {
+		b.Fatal(err)
+	}
+	interp, err := ctx.NewInterp(pkg)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := interp.RunFunc("Loop"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestSuperInstr(t *testing.T) {
+	var src string = `
+package main
+
+type point struct {
+	x, y int
+	_    int
+	name string
+}
+
+func main() {
+	s := []int{5, 3, 8, 1}
+	arr := [3]uint8{250, 3, 7}
+	var sum int
+	for i := 0; i < len(s); i++ {
+		sum += s[i]
+	}
+	var u uint8
+	for i := uint8(0); i < 3; i++ {
+		u += arr[i]
+	}
+	p := &point{}
+	p.x = sum
+	p.y = int(u) * 2
+	p.name = "p"
+	var f float64
+	for f < 2.5 {
+		f += 0.5
+	}
+	var k int8 = -100
+	for k > -120 {
+		k -= 7
+	}
+	println(sum, u, p.x, p.y, p.name, int(f*10), k)
+	defer func() {
+		println(recover().(error).Error())
+	}()
+	n := 4
+	println(s[n])
+}
+`
+	want := "17 4 17 8 p 25 -121\nruntime error: index out of range [4] with length 4\n"
+	for _, mode := range []igop.Mode{0, igop.DisableSuperInstr} {
+		var buf bytes.Buffer
+		ctx := igop.NewContext(mode)
+		ctx.SetPrintOutput(&buf)
+		if _, err := ctx.RunFile("main.go", src, nil); err != nil {
+			t.Fatal(err)
+		}
+		if s := buf.String(); s != want {
+			t.Fatalf("mode %v: bad output %q, want %q", mode, s, want)
+		}
+	}
+	// phis are assigned in parallel
+	src = `
+package main
+
+func main() {
+	a, b := 1, 2
+	for i := 0; i < 3; i++ {
+		a, b = b, a
+	}
+	println(a, b)
+}
+`
+	var buf bytes.Buffer
+	ctx := igop.NewContext(0)
+	ctx.SetPrintOutput(&buf)
+	if _, err := ctx.RunFile("main.go", src, nil); err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != "2 1\n" {
+		t.Fatalf("bad swap output %q", s)
+	}
+}
+
+func TestOptLevel(t *testing.T) {
+	var src string = `
+package main
+
+const debug = false
+
+type ID int
+
+func sum(s []int) (n int) {
+	for i := 0; i < len(s); i++ {
+		n += s[i]
+	}
+	for i := range s {
+		n += s[i]
+	}
+	return
+}
+
+func div(a, b int) int {
+	return a / b
+}
+
+func main() {
+	var x int8 = 100
+	x = x + 100
+	n := 3
+	u := uint8(n) - 4
+	a, b := 0.1, 0.2
+	id := ID(7) * 6
+	s := "a"
+	s += "b"
+	if debug {
+		println("unreachable")
+	}
+	v := 1
+	if !debug {
+		v = 2
+	}
+	one := 1
+	println(x, u, a+b == 0.3, id, s, v, one<<70>>68, sum([]int{1, 2, 3}))
+	defer func() {
+		println(recover().(error).Error())
+		defer func() {
+			println(recover().(error).Error())
+		}()
+		s := []int{1, 2}
+		for i := 0; i <= len(s); i++ {
+			_ = s[i]
+		}
+	}()
+	println(div(1, 0))
+}
+`
+	want := "-56 255 false 42 ab 2 0 12\nruntime error: integer divide by zero\nruntime error: index out of range [2] with length 2\n"
+	for level := 0; level <= 2; level++ {
+		var buf bytes.Buffer
+		ctx := igop.NewContext(0)
+		ctx.SetOptLevel(level)
+		ctx.SetPrintOutput(&buf)
+		if _, err := ctx.RunFile("main.go", src, nil); err != nil {
+			t.Fatal(err)
+		}
+		if s := buf.String(); s != want {
+			t.Fatalf("level %v: bad output %q, want %q", level, s, want)
+		}
+	}
+}
+
+func TestOptLevelSharedSSA(t *testing.T) {
+	var src string = `
+package main
+
+const debug = false
+
+func main() {
+	n := 3
+	v := 1
+	if !debug {
+		v = 2
+	}
+	if n*4+v != 14 || uint8(n)-4 != 255 {
+		panic("bad")
+	}
+}
+`
+	ctx := igop.NewContext(0)
+	ctx.SetOptLevel(2)
+	pkg, err := ctx.LoadFile("main.go", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dump := func() string {
+		var buf bytes.Buffer
+		for _, b := range pkg.Func("main").Blocks {
+			for _, instr := range b.Instrs {
+				fmt.Fprintln(&buf, instr)
+			}
+		}
+		return buf.String()
+	}
+	orig := dump()
+	var wg sync.WaitGroup
+	for level := 0; level <= 2; level++ {
+		for n := 0; n < 2; n++ {
+			ctx.SetOptLevel(level)
+			interp, err := ctx.NewInterp(pkg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := interp.RunInit(); err != nil {
+					t.Error(err)
+				}
+				if _, err := interp.RunFunc("main"); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+	if s := dump(); s != orig {
+		t.Fatalf("SSA changed by optimizer:\n%v\nwant:\n%v", s, orig)
+	}
+}
+
+func TestInline(t *testing.T) {
+	var src string = `
+package main
+
+import (
+	"runtime"
+	"strings"
+)
+
+type point struct {
+	x, y int
+}
+
+func add(a, b int) int {
+	return a + b
+}
+
+func divmod(a, b int) (int, int) {
+	return a / b, a % b
+}
+
+func getX(p *point) int {
+	return p.x
+}
+
+func setY(p *point, y int) {
+	p.y = y
+}
+
+func at(s []int, i int) int {
+	return s[i]
+}
+
+func main() {
+	var sum int
+	for i := 0; i < 10; i++ {
+		sum = add(sum, i)
+	}
+	q, r := divmod(17, 5)
+	p := &point{x: 3}
+	setY(p, getX(p)*2)
+	k := 10
+	scale := func(v int) int {
+		return v * k
+	}
+	println(sum, q, r, p.x, p.y, scale(4))
+	defer func() {
+		println(recover().(error).Error())
+		buf := make([]byte, 4096)
+		stack := string(buf[:runtime.Stack(buf, false)])
+		println(strings.Contains(stack, "main.at()\n\tmain.go:30"))
+	}()
+	println(at([]int{1, 2}, 3))
+}
+`
+	want := "45 3 2 3 6 40\nruntime error: index out of range [3] with length 2\ntrue\n"
+	for _, mode := range []igop.Mode{0, igop.DisableInline} {
+		var buf bytes.Buffer
+		ctx := igop.NewContext(mode)
+		ctx.SetPrintOutput(&buf)
+		if _, err := ctx.RunFile("main.go", src, nil); err != nil {
+			t.Fatal(err)
+		}
+		if s := buf.String(); s != want {
+			t.Fatalf("mode %v: bad output %q, want %q", mode, s, want)
+		}
+	}
+}
+
+func TestLazyCompile(t *testing.T) {
+	var src string = `
+package main
+
+import "sync"
+
+func work(n int) (r int) {
+	defer func() {
+		if recover() != nil {
+			r = -1
+		}
+	}()
+	return 100 / n
+}
+
+func main() {
+	var wg sync.WaitGroup
+	res := make([]int, 8)
+	for i := range res {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res[i] = work(i)
+		}(i)
+	}
+	wg.Wait()
+	f := work
+	println(res[0], res[1], res[4], f(0), f(50))
+}
+`
+	for _, mode := range []igop.Mode{0, igop.EagerCompile} {
+		var buf bytes.Buffer
+		ctx := igop.NewContext(mode)
+		ctx.SetPrintOutput(&buf)
+		if _, err := ctx.RunFile("main.go", src, nil); err != nil {
+			t.Fatal(err)
+		}
+		if s := buf.String(); s != "-1 100 25 -1 2\n" {
+			t.Fatalf("mode %v: bad output %q", mode, s)
+		}
+	}
+	// patch the function not compiled
+	ctx := igop.NewContext(0)
+	var buf bytes.Buffer
+	ctx.SetPrintOutput(&buf)
+	pkg, err := ctx.LoadFile("main.go", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	interp, err := ctx.NewInterp(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := interp.Patch("main.work", func(n int) int { return n }); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = ctx.RunInterp(interp, "main.go", nil); err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != "0 1 4 0 50\n" {
+		t.Fatalf("bad patch output %q", s)
+	}
+}
+
+func TestProgram(t *testing.T) {
+	var src string = `
+package main
+
+import "fmt"
+
+var n = 10
+
+func init() {
+	n++
+}
+
+func add(k int) int {
+	n += k
+	return n
+}
+
+func Run(k int) string {
+	f := func() int { return add(k) }
+	f()
+	return fmt.Sprintf("T(%v) %v", k+n, n)
+}
+`
+	ctx := igop.NewContext(0)
+	pkg, err := ctx.LoadFile("main.go", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prog, err := ctx.NewProgram(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	res := make([]string, 8)
+	errs := make([]error, len(res))
+	for k := range res {
+		wg.Add(1)
+		go func(k int) {
+			defer wg.Done()
+			interp, err := prog.NewInterp()
+			if err == nil {
+				err = interp.RunInit()
+			}
+			if err != nil {
+				errs[k] = err
+				return
+			}
+			for j := 0; j < 2; j++ {
+				var r igop.Value
+				if r, err = interp.RunFunc("Run", k); err != nil {
+					errs[k] = err
+					return
+				}
+				res[k] = r.(string)
+			}
+		}(k)
+	}
+	wg.Wait()
+	for k, s := range res {
+		if errs[k] != nil {
+			t.Fatal(errs[k])
+		}
+		if v := 11 + 2*k; s != fmt.Sprintf("T(%v) %v", k+v, v) {
+			t.Fatalf("interp %v: bad result %q", k, s)
+		}
+	}
+	if _, err := igop.NewContext(igop.CheckDataRace).NewProgram(pkg); err != igop.ErrProgramMode {
+		t.Fatalf("must error %v", err)
+	}
+}
+
+func TestProgramPatchConcurrent(t *testing.T) {
+	var src string = `
+package main
+
+func Add(a, b int) int {
+	return a + b
+}
+
+func Run(k int) int {
+	return Add(k, k)
+}
+`
+	ctx := igop.NewContext(0)
+	pkg, err := ctx.LoadFile("main.go", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prog, err := ctx.NewProgram(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	res := make([]int, 8)
+	errs := make([]error, len(res))
+	for k := range res {
+		wg.Add(1)
+		go func(k int) {
+			defer wg.Done()
+			interp, err := prog.NewInterp()
+			if err == nil {
+				_, err = interp.Patch("main.Add", func(a, b int) int {
+					return a * b
+				})
+			}
+			if err == nil {
+				err = interp.RunInit()
+			}
+			var r igop.Value
+			if err == nil {
+				r, err = interp.RunFunc("Run", k)
+			}
+			if err != nil {
+				errs[k] = err
+				return
+			}
+			res[k] = r.(int)
+		}(k)
+	}
+	wg.Wait()
+	for k, v := range res {
+		if errs[k] != nil {
+			t.Fatal(errs[k])
+		}
+		if v != k*k {
+			t.Fatalf("interp %v: bad result %v", k, v)
+		}
+	}
+}
+
+func TestProgramNativeGoroutine(t *testing.T) {
+	var src string = `
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+var prefix string
+
+func init() {
+	prefix = "T"
+}
+
+type T int
+
+func (t T) String() string {
+	return fmt.Sprintf("%v%v", prefix, int(t))
+}
+
+func Run(k int) string {
+	ch := make(chan string)
+	time.AfterFunc(time.Millisecond, func() {
+		ch <- fmt.Sprint(T(k))
+	})
+	return <-ch
+}
+`
+	ctx := igop.NewContext(0)
+	pkg, err := ctx.LoadFile("main.go", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prog, err := ctx.NewProgram(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k := 0; k < 2; k++ {
+		interp, err := prog.NewInterp()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := interp.RunInit(); err != nil {
+			t.Fatal(err)
+		}
+		r, err := interp.RunFunc("Run", k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := fmt.Sprintf("T%v", k); r != s {
+			t.Fatalf("interp %v: bad result %q", k, r)
+		}
+	}
+}
+
+func TestStackOverflow(t *testing.T) {
+	var src string = `
+package main
+
+func sum(n int) int {
+	if n == 0 {
+		return 0
+	}
+	return n + sum(n-1)
+}
+
+func dsum(n int, fail bool) (r int) {
+	defer func() {
+		r += n
+	}()
+	if n == 0 && fail {
+		panic("zero")
+	}
+	if n == 0 {
+		return 0
+	}
+	return dsum(n-1, fail)
+}
+
+func psum(n int) (r int) {
+	defer func() {
+		if recover() != nil {
+			r = -1
+		}
+	}()
+	return dsum(n, true)
+}
+
+func main() {
+	f := sum
+	println(sum(100000), f(10), dsum(100000, false), psum(1000))
+}
+`
+	var buf bytes.Buffer
+	ctx := igop.NewContext(0)
+	ctx.SetPrintOutput(&buf)
+	// the interpreted calls not use goroutine stack
+	old := debug.SetMaxStack(1 << 20)
+	_, err := ctx.RunFile("main.go", src, nil)
+	debug.SetMaxStack(old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != "5000050000 55 5000050000 -1\n" {
+		t.Fatalf("bad output %q", s)
+	}
+	src = `
+package main
+
+func loop(n int) int {
+	defer func() {
+		recover()
+	}()
+	return loop(n + 1)
+}
+
+func main() {
+	loop(0)
+}
+`
+	ctx = igop.NewContext(0)
+	ctx.SetMaxCallDepth(10000)
+	_, err = ctx.RunFile("main.go", src, nil)
+	e, ok := err.(igop.PanicError)
+	if !ok || e.Value != igop.ErrStackOverflow {
+		t.Fatalf("must stack overflow: %v", err)
+	}
+	if !strings.Contains(string(e.Stack()), "main.loop(") {
+		t.Fatalf("bad stack %s", e.Stack())
+	}
+}
+
+func TestStackMethodCall(t *testing.T) {
+	var src string = `
+package main
+
+type Summer interface {
+	Sum(n int) int
+}
+
+type T struct{}
+
+func (t T) Sum(n int) int {
+	if n == 0 {
+		return 0
+	}
+	var s Summer = t
+	return n + s.Sum(n-1)
+}
+
+func main() {
+	println(T{}.Sum(100000))
+}
+`
+	var buf bytes.Buffer
+	ctx := igop.NewContext(0)
+	ctx.SetPrintOutput(&buf)
+	// the interface method calls not use goroutine stack
+	old := debug.SetMaxStack(1 << 20)
+	_, err := ctx.RunFile("main.go", src, nil)
+	debug.SetMaxStack(old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := buf.String(); s != "5000050000\n" {
+		t.Fatalf("bad output %q", s)
+	}
+}
+
+func TestContinuation(t *testing.T) {
+	var src string = `
+package main
+
+import "fmt"
+
+type Step struct {
+	Name string
+	N    int
+	next *Step
+}
+
+var total = 100
+
+func Await(name string) int {
+	panic("not suspended")
+}
+
+func run(k int) string {
+	s := &Step{Name: "a", N: k}
+	s.next = s
+	m := map[string]int{"x": 1}
+	f := func(n int) int { return n + k + m["x"] }
+	var names []string
+	for i := 0; i < 3; i++ {
+		v := Await(fmt.Sprint("step", i))
+		s.N += v
+		names = append(names, s.next.Name)
+		m["x"] += v
+	}
+	total += s.N
+	return fmt.Sprint(f(s.N), names, total)
+}
+
+func Run(k int) string {
+	return run(k)
+}
+
+func Defer() {
+	defer println("defer")
+	Await("defer")
+}
+
+var pair [2]int
+
+func Alias() int {
+	a := make([]int, 2, 4)
+	b := a[:1]
+	s := &Step{N: 1}
+	n := &s.N
+	q := &pair[1]
+	Await("alias")
+	b[0] = 1
+	a = append(a, 2)
+	*n += 10
+	*q = 7
+	return a[0] + len(a)*10 + cap(b)*100 + s.N*1000 + pair[1]*100000
+}
+`
+	var buf bytes.Buffer
+	// resume by the interp of new context and program
+	newInterp := func(src string) *igop.Interp {
+		ctx := igop.NewContext(0)
+		ctx.SetPrintOutput(&buf)
+		ctx.RegisterSuspend("main.Await")
+		pkg, err := ctx.LoadFile("main.go", src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		interp, err := ctx.NewInterp(pkg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := interp.RunInit(); err != nil {
+			t.Fatal(err)
+		}
+		return interp
+	}
+	interp := newInterp(src)
+	_, err := interp.RunFunc("Run", 2)
+	c, ok := err.(*igop.Continuation)
+	if !ok {
+		t.Fatalf("must suspend: %v", err)
+	}
+	for n := 1; n <= 3; n++ {
+		if c.Func() != "main.Await" || c.Args()[0] != fmt.Sprint("step", n-1) {
+			t.Fatalf("bad suspend %v %v", c.Func(), c.Args())
+		}
+		// resume by the other interp
+		data, err := c.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		interp = newInterp(src)
+		if c, err = interp.UnmarshalContinuation(data); err != nil {
+			t.Fatal(err)
+		}
+		r, err := interp.Resume(c, n*10)
+		if n == 3 {
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r != "125 [a a a] 162" {
+				t.Fatalf("bad result %v", r)
+			}
+			break
+		}
+		if c, ok = err.(*igop.Continuation); !ok {
+			t.Fatalf("must suspend: %v", err)
+		}
+	}
+	if _, err := interp.Resume(c, 1); err != igop.ErrResumed {
+		t.Fatalf("must resumed error: %v", err)
+	}
+	_, err = interp.RunFunc("Defer")
+	if e, ok := err.(igop.PanicError); !ok || e.Value != igop.ErrNotSuspendable {
+		t.Fatalf("must not suspendable: %v", err)
+	}
+	_, err = newInterp(src).RunFunc("Alias")
+	if c, ok = err.(*igop.Continuation); !ok {
+		t.Fatalf("must suspend: %v", err)
+	}
+	data, err := c.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the function changed is error
+	changed := strings.Replace(src, "*q = 7", "*q = 7\n\t*q++", 1)
+	if _, err := newInterp(changed).UnmarshalContinuation(data); err == nil || !strings.Contains(err.Error(), "layout") {
+		t.Fatalf("must layout error: %v", err)
+	}
+	// the bad data is error
+	interp = newInterp(src)
+	for n := range data {
+		if _, err := interp.UnmarshalContinuation(data[:n]); err == nil {
+			t.Fatalf("must error of data[:%v]", n)
+		}
+		bad := append([]byte{}, data...)
+		bad[n] ^= 0xff
+		interp.UnmarshalContinuation(bad)
+	}
+	if c, err = interp.UnmarshalContinuation(data); err != nil {
+		t.Fatal(err)
+	}
+	// the slices of same array and the pointers to field are shared
+	if r, err := interp.Resume(c, 0); err != nil || r != 711431 {
+		t.Fatalf("bad resume %v %v", r, err)
+	}
+}
+
+func TestPromotePackage(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("build plugin on linux")
+	}
+	var src string = `
+package main
+
+var calls int
+
+func fib(n int) int {
+	if n < 2 {
+		return n
+	}
+	return fib(n-1) + fib(n-2)
+}
+
+func divmod(a, b int) (int, int) {
+	return a / b, a % b
+}
+
+func count(s string, c byte) (n int) {
+	for i := 0; i < len(s); i++ {
+		if s[i] == c {
+			n++
+		}
+	}
+	return
+}
+
+func Sum(s string, k int) float64 {
+	q, r := divmod(fib(k), 7)
+	return float64(q)*0.5 + float64(r) + float64(count(s[1:], 'a'))
+}
+
+func Count() int {
+	calls++
+	return calls
+}
+`
+	ctx := igop.NewContext(0)
+	pkg, err := ctx.LoadFile("main.go", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	interp, err := ctx.NewInterp(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := interp.RunInit(); err != nil {
+		t.Fatal(err)
+	}
+	want, err := interp.RunFunc("Sum", "banana", 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	file, funcs, err := interp.BuildPackagePlugin("main", dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := fmt.Sprint(funcs); s != "[main.Sum main.count main.divmod main.fib]" {
+		t.Fatalf("bad promoted funcs %v", s)
+	}
+	if _, err := os.Stat(file); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := interp.PromotePackage("main", dir); err == igop.ErrNoPlugin {
+		// run the test built with tag igop_plugin
+		cmd := exec.Command(filepath.Join(runtime.GOROOT(), "bin", "go"), "test", "-tags", "igop_plugin", "-count=1", "-run", "^TestPromotePackage$", ".")
+		cmd.Env = append(os.Environ(), "GOTOOLCHAIN=local")
+		if out, err := cmd.CombinedOutput(); bytes.Contains(out, []byte("[build failed]")) {
+			t.Skipf("build with tag igop_plugin failed\n%s", out)
+		} else if err != nil {
+			t.Fatalf("%v\n%s", err, out)
+		}
+		return
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	if r, err := interp.RunFunc("Sum", "banana", 20); err != nil || r != want {
+		t.Fatalf("bad result %v %v, want %v", r, err, want)
+	}
+	if _, err := interp.RunFunc("divmod", 1, 0); err == nil || !strings.Contains(err.Error(), "divide by zero") {
+		t.Fatalf("must divide by zero: %v", err)
+	}
+	if r, err := interp.RunFunc("Count"); err != nil || r != 1 {
+		t.Fatalf("bad result %v %v", r, err)
+	}
 }
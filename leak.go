@@ -0,0 +1,241 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const waitReasonSleep = "sleep"
+
+// leakTimeout is the max time wait for goroutines exit after main returns.
+var leakTimeout = time.Second
+
+type leakGoroutine struct {
+	id       int64
+	created  string // function and position of go statement
+	test     string // name of test created in, empty if not in test
+	mu       sync.Mutex
+	frame    *frame         // current frame set at call boundaries
+	wait     *waitGoroutine // current blocked frame, nil if running
+	reported bool           // reported by test check
+}
+
+// leakChecker track the interp goroutines alive and the frame blocked on
+// channel operations, sleep and sync.WaitGroup.Wait. The goroutines of test
+// are checked after test function returns.
+type leakChecker struct {
+	interp     *Interp
+	goroutines sync.Map // goroutine id -> *leakGoroutine
+	tests      sync.Map // goroutine id of running test -> test name
+	alives     sync.Map // test name -> *int32 number of goroutines created in test
+}
+
+func newLeakChecker(interp *Interp) *leakChecker {
+	return &leakChecker{interp: interp}
+}
+
+// enter record the current goroutine start by go statement at created in
+// test.
+func (l *leakChecker) enter(created string, test string) *leakGoroutine {
+	g := &leakGoroutine{id: goroutineID(), created: created, test: test}
+	l.goroutines.Store(g.id, g)
+	return g
+}
+
+// start set the current frame of current goroutine, it is called at call
+// boundaries. The callers of current frame are not changed until it returns,
+// the stack of running goroutine is dumped by the callers under lock.
+func (l *leakChecker) start(fr *frame) {
+	if v, ok := l.goroutines.Load(goroutineID()); ok {
+		g := v.(*leakGoroutine)
+		g.mu.Lock()
+		g.frame = fr
+		g.mu.Unlock()
+	}
+}
+
+// testOf return the test name of current goroutine.
+func (l *leakChecker) testOf() string {
+	id := goroutineID()
+	if v, ok := l.goroutines.Load(id); ok {
+		return v.(*leakGoroutine).test
+	}
+	if v, ok := l.tests.Load(id); ok {
+		return v.(string)
+	}
+	return ""
+}
+
+func (l *leakChecker) exit(g *leakGoroutine) {
+	l.goroutines.Delete(g.id)
+	if g.test != "" {
+		v, _ := l.alives.Load(g.test)
+		atomic.AddInt32(v.(*int32), -1)
+	}
+}
+
+// spawn return the test name of current goroutine for go statement, the
+// goroutine is alive before it start.
+func (l *leakChecker) spawn() string {
+	test := l.testOf()
+	if test != "" {
+		v, _ := l.alives.LoadOrStore(test, new(int32))
+		atomic.AddInt32(v.(*int32), 1)
+	}
+	return test
+}
+
+// block record the current goroutine blocked at fr, return nil if not
+// goroutine of interp.
+func (l *leakChecker) block(fr *frame, reason string) *leakGoroutine {
+	v, ok := l.goroutines.Load(goroutineID())
+	if !ok {
+		return nil
+	}
+	g := v.(*leakGoroutine)
+	g.mu.Lock()
+	g.wait = &waitGoroutine{fr: fr, reason: reason}
+	g.mu.Unlock()
+	return g
+}
+
+func (l *leakChecker) unblock(g *leakGoroutine) {
+	if g == nil {
+		return
+	}
+	g.mu.Lock()
+	g.wait = nil
+	g.mu.Unlock()
+}
+
+// check wait the goroutines exit, return GoroutineLeakError if still alive.
+func (l *leakChecker) check() error {
+	deadline := time.Now().Add(leakTimeout)
+	for atomic.LoadInt32(&l.interp.goroutines) > 1 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if stack := l.dump(""); len(stack) > 0 {
+		return GoroutineLeakError{stack: stack}
+	}
+	return nil
+}
+
+// checkTest wait the goroutines created in test exit, return
+// GoroutineLeakError if still alive. The leaked goroutines are reported
+// once.
+func (l *leakChecker) checkTest(test string) error {
+	deadline := time.Now().Add(leakTimeout)
+	v, _ := l.alives.LoadOrStore(test, new(int32))
+	for atomic.LoadInt32(v.(*int32)) > 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if stack := l.dump(test); len(stack) > 0 {
+		return GoroutineLeakError{stack: stack}
+	}
+	return nil
+}
+
+// leakTestFuncs is the funcs of testing run tests wrapped by wrapMainStart.
+var leakTestFuncs = map[string]bool{
+	"testing.MainStart": true,
+	"testing.RunTests":  true,
+}
+
+// wrapMainStart wrap the tests of testing.MainStart and testing.RunTests to
+// check goroutines leak after each test.
+func (l *leakChecker) wrapMainStart(ext reflect.Value) reflect.Value {
+	return reflect.MakeFunc(ext.Type(), func(args []reflect.Value) []reflect.Value {
+		if tests := args[1]; tests.Kind() == reflect.Slice {
+			list := reflect.MakeSlice(tests.Type(), tests.Len(), tests.Len())
+			reflect.Copy(list, tests)
+			for i := 0; i < list.Len(); i++ {
+				name := list.Index(i).FieldByName("Name").String()
+				f := list.Index(i).FieldByName("F")
+				f.Set(l.wrapTest(name, reflect.ValueOf(f.Interface())))
+			}
+			args[1] = list
+		}
+		return ext.Call(args)
+	})
+}
+
+// wrapTest run the test f and report goroutines leak by t.Errorf.
+func (l *leakChecker) wrapTest(name string, f reflect.Value) reflect.Value {
+	return reflect.MakeFunc(f.Type(), func(args []reflect.Value) []reflect.Value {
+		id := goroutineID()
+		l.tests.Store(id, name)
+		results := f.Call(args)
+		l.tests.Delete(id)
+		if err := l.checkTest(name); err != nil {
+			e := err.(GoroutineLeakError)
+			args[0].MethodByName("Errorf").Call([]reflect.Value{
+				reflect.ValueOf("%v\n\n%s"), reflect.ValueOf(e.Error()), reflect.ValueOf(e.Stack())})
+		}
+		return results
+	})
+}
+
+// dump return the stack and created position of alive goroutines, only
+// goroutines created in test if test is not empty.
+func (l *leakChecker) dump(test string) []byte {
+	var gs []*leakGoroutine
+	l.goroutines.Range(func(k, v interface{}) bool {
+		g := v.(*leakGoroutine)
+		g.mu.Lock()
+		if !g.reported && (test == "" || g.test == test) {
+			g.reported = test != ""
+			gs = append(gs, g)
+		}
+		g.mu.Unlock()
+		return true
+	})
+	sort.Slice(gs, func(i, j int) bool {
+		return gs[i].id < gs[j].id
+	})
+	var buf bytes.Buffer
+	for n, g := range gs {
+		if n > 0 {
+			buf.WriteByte('\n')
+		}
+		g.mu.Lock()
+		if w := g.wait; w != nil {
+			fmt.Fprintf(&buf, "goroutine %v [%v]:\n", g.id, w.reason)
+			writeStack(&buf, w.fr)
+		} else {
+			fmt.Fprintf(&buf, "goroutine %v [running]:\n", g.id)
+			if fr := g.frame; fr != nil && fr.pfn != nil {
+				// the pc of current frame is changing, write the function
+				fn := fr.pfn.Fn
+				pos := fn.Prog.Fset.Position(fn.Pos())
+				fmt.Fprintf(&buf, "%v()\n\t%v:%v\n", fn, pos.Filename, pos.Line)
+				if fr.caller.valid() {
+					writeStack(&buf, fr.caller)
+				}
+			}
+		}
+		g.mu.Unlock()
+		fmt.Fprintf(&buf, "created by %v\n", g.created)
+	}
+	return buf.Bytes()
+}
@@ -25,6 +25,7 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"golang.org/x/mod/modfile"
 )
@@ -106,3 +107,34 @@ func ParseModFile(file string) (*modfile.File, error) {
 	}
 	return f, nil
 }
+
+var (
+	goVersions sync.Map // go.mod file => go version
+)
+
+// GetGoVersion get the go directive of module contains dir, eg "go1.22".
+// Return empty if not found go.mod or go directive.
+func GetGoVersion(dir string) string {
+	dir, err := absDir(dir)
+	if err != nil {
+		return ""
+	}
+	mod, found := findModule(dir)
+	if !found {
+		return ""
+	}
+	if v, ok := goVersions.Load(mod); ok {
+		return v.(string)
+	}
+	var ver string
+	if f, err := ParseModFile(mod); err == nil && f.Go != nil {
+		// language version go1.x, drop the patch of go1.x.y
+		ar := strings.Split(f.Go.Version, ".")
+		if len(ar) > 2 {
+			ar = ar[:2]
+		}
+		ver = "go" + strings.Join(ar, ".")
+	}
+	goVersions.Store(mod, ver)
+	return ver
+}
@@ -18,6 +18,7 @@ package igop
 
 import (
 	"fmt"
+	"go/constant"
 	"go/token"
 	"go/types"
 	"io"
@@ -117,6 +118,10 @@ type function struct {
 	Recover    []func(fr *frame)            // recover instrs
 	Blocks     []int                        // block offset
 	stack      []value                      // results args envs datas
+	unboxed    map[ssa.Value]int            // unboxed value -> index of ints or floats bank
+	nints      int                          // unboxed integer and bool count
+	nfloats    int                          // unboxed float count
+	nobounds   map[ssa.Instruction]bool     // IndexAddr need not check bounds
 	ssaInstrs  []ssa.Instruction            // org ssa instr
 	base       int                          // base of interp
 	nres       int                          // results count
@@ -124,6 +129,17 @@ type function struct {
 	nenv       int                          // closure free vars count
 	used       int32                        // function used count
 	cached     int32                        // enable cached by pool
+	patched    int32                        // active patch count, inlined call not run instrs if patched
+	patches    patchChain                   // patches of Interp.Patch
+	patchOnce  sync.Once                    // init patchEntry
+	patchEntry atomic.Value                 // *function allocate frame when patched
+	compiled   int32                        // instrs is compiled
+	id         int                          // index of Interp.fns for the interp of Program
+
+	// side tables of optimizer, the SSA of Fn is shared and not changed
+	values map[ssa.Value]ssa.Value               // replaced operands
+	live   map[*ssa.BasicBlock][]ssa.Instruction // live instrs of reachable blocks
+	refs   map[ssa.Value][]ssa.Instruction       // referrers by live instrs
 }
 
 func (p *function) UnsafeRelease() {
@@ -145,11 +161,26 @@ func (p *function) initPool() {
 	p.pool.New = func() interface{} {
 		fr := &frame{interp: p.Interp, pfn: p, block: p.Main}
 		fr.stack = append([]value{}, p.stack...)
+		p.allocBanks(fr)
 		return fr
 	}
 }
 
 func (p *function) allocFrame(caller *frame) *frame {
+	if i := caller.interp; i != nil && i.fns != nil && p.Interp != i {
+		p = i.fns[p.id]
+	}
+	if max := p.Interp.conf.maxDepth; max > 0 && caller.depth >= max {
+		panic(PanicError{stack: debugStack(caller), Value: ErrStackOverflow})
+	}
+	if atomic.LoadInt32(&p.compiled) == 0 {
+		p.compile()
+	}
+	if atomic.LoadInt32(&p.patched) != 0 {
+		if entry, ok := p.patchEntry.Load().(*function); ok {
+			p = entry
+		}
+	}
 	var fr *frame
 	if atomic.LoadInt32(&p.cached) == 1 {
 		fr = p.pool.Get().(*frame)
@@ -159,24 +190,47 @@ func (p *function) allocFrame(caller *frame) *frame {
 		fr.ipc = 0
 		fr.pred = 0
 	} else {
-		if atomic.AddInt32(&p.used, 1) > int32(p.Interp.ctx.callForPool) {
+		if atomic.AddInt32(&p.used, 1) > int32(p.Interp.conf.callForPool) {
 			atomic.StoreInt32(&p.cached, 1)
 		}
 		fr = &frame{interp: p.Interp, pfn: p, block: p.Main}
 		fr.stack = append([]value{}, p.stack...)
+		p.allocBanks(fr)
 	}
 	fr.caller = caller
+	fr.depth = caller.depth + 1
 	fr.deferid = caller.deferid
 	caller.callee = fr
+	if l := p.Interp.leak; l != nil {
+		l.start(fr)
+	}
 	return fr
 }
 
+// hasRecover check the function run recover block on panic, it not need
+// compiled instrs.
+func (p *function) hasRecover() bool {
+	return p.Fn.Recover != nil && p.Interp.conf.mode&DisableRecover == 0
+}
+
+func (p *function) allocBanks(fr *frame) {
+	if p.nints > 0 {
+		fr.ints = make([]uint64, p.nints)
+	}
+	if p.nfloats > 0 {
+		fr.floats = make([]float64, p.nfloats)
+	}
+}
+
 func (p *function) deleteFrame(caller *frame, fr *frame) {
+	p = fr.pfn // the function of interp allocated frame
+	if l := p.Interp.leak; l != nil {
+		l.start(caller)
+	}
 	if atomic.LoadInt32(&p.cached) == 1 {
 		p.pool.Put(fr)
-	} else {
-		caller.callee = nil
 	}
+	caller.callee = nil
 	fr = nil
 }
 
@@ -214,6 +268,7 @@ func (p *function) regIndex(v ssa.Value) register {
 }
 
 func (p *function) regInstr(v ssa.Value) uint32 {
+	v = p.value(v)
 	if i, ok := p.index[v]; ok {
 		return i
 	}
@@ -226,8 +281,14 @@ func (p *function) regInstr(v ssa.Value) uint32 {
 	case *ssa.Global:
 		vs, _ = globalToValue(p.Interp, v)
 		vk = kindGlobal
+		if p.Interp.program != nil {
+			vk = kindInvalid // set by each interp of program
+		}
 	case *ssa.Function:
 		vk = kindFunction
+		if p.Interp.program != nil && v.Blocks != nil {
+			vk = kindInvalid // set by each interp of program
+		}
 		if v.Blocks != nil {
 			typ := p.Interp.preToType(v.Type())
 			pfn := p.Interp.loadFunction(v)
@@ -333,7 +394,35 @@ func checkFuncCompatible(t1, t2 reflect.Type) bool {
 }
 
 func findExternFunc(interp *Interp, fn *ssa.Function) (ext reflect.Value, ok bool) {
+	if r := interp.race; r != nil && isRaceSyncFunc(fn) {
+		defer func() {
+			if ok {
+				ext = r.syncFunc(fn, ext)
+			}
+		}()
+	}
 	fnName := fn.String()
+	if l := interp.leak; l != nil && leakTestFuncs[fnName] {
+		defer func() {
+			if ok {
+				ext = l.wrapMainStart(ext)
+			}
+		}()
+	}
+	if r := interp.recorder; r != nil && recordFuncs[fnName] {
+		defer func() {
+			if ok {
+				ext = r.wrap(fnName, ext)
+			}
+		}()
+	}
+	if p, found := interp.natives[fnName]; found {
+		defer func() {
+			if ok {
+				ext = p.wrap(ext)
+			}
+		}()
+	}
 	ext, ok = findExternValue(interp, fnName)
 	if ok {
 		typ := interp.preToType(fn.Type())
@@ -357,7 +446,32 @@ func findExternFunc(interp *Interp, fn *ssa.Function) (ext reflect.Value, ok boo
 	return
 }
 
+// findExternThunk return the typed call thunk of register func, skip if the
+// func is override or wrapped.
+func findExternThunk(interp *Interp, fn *ssa.Function) (thunk CallThunk, ok bool) {
+	if fn.Pkg == nil || fn.Signature.Recv() != nil {
+		return
+	}
+	fnName := fn.String()
+	if _, found := findExternValue(interp, fnName); found {
+		return
+	}
+	if interp.race != nil && isRaceSyncFunc(fn) || interp.recorder != nil && recordFuncs[fnName] ||
+		interp.leak != nil && leakTestFuncs[fnName] || interp.natives[fnName] != nil {
+		return
+	}
+	if pkg, found := interp.installed(fn.Pkg.Pkg.Path()); found {
+		thunk, ok = pkg.Thunks[fn.Name()]
+	}
+	return
+}
+
 func makeInstr(interp *Interp, pfn *function, instr ssa.Instruction) func(fr *frame) {
+	if pfn.unboxed != nil {
+		if ifn := makeUnboxedInstr(pfn, instr); ifn != nil {
+			return ifn
+		}
+	}
 	switch instr := instr.(type) {
 	case *ssa.Alloc:
 		if instr.Heap {
@@ -508,13 +622,26 @@ func makeInstr(interp *Interp, pfn *function, instr ssa.Instruction) func(fr *fr
 			for i := range instr.Bindings {
 				bindings = append(bindings, fr.reg(ib[i]))
 			}
-			v := pfn.makeFunction(typ, bindings)
+			v := fr.interp.funcOf(pfn).makeFunction(typ, bindings)
 			fr.setReg(ir, v.Interface())
 		}
 	case *ssa.MakeChan:
 		typ := interp.preToType(instr.Type())
 		ir := pfn.regIndex(instr)
 		is := pfn.regIndex(instr.Size)
+		if d := interp.deadlock; d != nil {
+			ctyp := reflect.ChanOf(reflect.BothDir, typ.Elem())
+			return func(fr *frame) {
+				size := fr.reg(is)
+				buffer := asInt(size)
+				if buffer < 0 {
+					panic(runtimeError("makechan: size out of range"))
+				}
+				ch := reflect.MakeChan(ctyp, buffer)
+				d.makeChan(ch)
+				fr.setReg(ir, ch.Convert(typ).Interface())
+			}
+		}
 		if typ.ChanDir() == reflect.BothDir {
 			return func(fr *frame) {
 				size := fr.reg(is)
@@ -605,6 +732,12 @@ func makeInstr(interp *Interp, pfn *function, instr ssa.Instruction) func(fr *fr
 		ir := pfn.regIndex(instr)
 		ix := pfn.regIndex(instr.X)
 		ii := pfn.regIndex(instr.Index)
+		if pfn.nobounds[instr] {
+			return func(fr *frame) {
+				v := reflect.ValueOf(fr.reg(ix))
+				fr.setReg(ir, v.Index(asInt(fr.reg(ii))).Addr().Interface())
+			}
+		}
 		return func(fr *frame) {
 			x := fr.reg(ix)
 			idx := fr.reg(ii)
@@ -718,8 +851,19 @@ func makeInstr(interp *Interp, pfn *function, instr ssa.Instruction) func(fr *fr
 					Send: send,
 				})
 			}
-			chosen, recv, recvOk := reflect.Select(cases)
-			if !instr.Blocking {
+			var chosen int
+			var recv reflect.Value
+			var recvOk bool
+			if instr.Blocking {
+				chosen, recv, recvOk = fr.interp.chanSelect(fr, cases)
+				if chosen == -1 {
+					return // interp aborted
+				}
+			} else if g := fr.interp.sched.current(); g != nil {
+				fr.interp.sched.yield(g)
+				chosen, recv, recvOk = fr.interp.sched.trySelect(g, cases[1:])
+			} else {
+				chosen, recv, recvOk = reflect.Select(cases)
 				chosen-- // default case should have index -1.
 			}
 			r := tuple{chosen, recvOk}
@@ -763,6 +907,12 @@ func makeInstr(interp *Interp, pfn *function, instr ssa.Instruction) func(fr *fr
 				fr.setReg(ir, &stringIter{Reader: strings.NewReader(v)})
 			}
 		case reflect.Map:
+			if s := interp.sched; s != nil {
+				return func(fr *frame) {
+					v := fr.reg(ix)
+					fr.setReg(ir, s.mapIter(reflect.ValueOf(v)))
+				}
+			}
 			return func(fr *frame) {
 				v := fr.reg(ix)
 				fr.setReg(ir, &mapIter{iter: reflect.ValueOf(v).MapRange()})
@@ -796,7 +946,7 @@ func makeInstr(interp *Interp, pfn *function, instr ssa.Instruction) func(fr *fr
 			fr.setReg(ir, typeAssert(interp, instr, typ, xtyp, v))
 		}
 	case *ssa.Extract:
-		if *instr.Referrers() == nil {
+		if pfn.referrers(instr) == nil {
 			return nil
 		}
 		ir := pfn.regIndex(instr)
@@ -849,6 +999,9 @@ func makeInstr(interp *Interp, pfn *function, instr ssa.Instruction) func(fr *fr
 			}
 		}
 	case *ssa.Return:
+		if hasRangeFuncDefers(instr.Parent()) {
+			return makeRangeFuncReturn(pfn, instr)
+		}
 		switch n := pfn.nres; n {
 		case 0:
 			return func(fr *frame) {
@@ -910,44 +1063,74 @@ func makeInstr(interp *Interp, pfn *function, instr ssa.Instruction) func(fr *fr
 			fr.runDefers()
 		}
 	case *ssa.Panic:
+		if err, ok := rangeFuncError(instr); ok {
+			return func(fr *frame) {
+				panic(PanicError{stack: debugStack(fr), Value: runtimeError(err)})
+			}
+		}
 		ix := pfn.regIndex(instr.X)
 		return func(fr *frame) {
 			panic(PanicError{stack: debugStack(fr), Value: fr.reg(ix)})
 		}
 	case *ssa.Go:
 		iv, ia, ib := getCallIndex(pfn, &instr.Call)
+		var created string
+		if interp.leak != nil {
+			created = fmt.Sprintf("%v\n\t%v", pfn.Fn, interp.ctx.FileSet.Position(instr.Pos()))
+		}
 		return func(fr *frame) {
+			interp := fr.interp
 			fn, args := interp.prepareCall(fr, &instr.Call, iv, ia, ib)
 			atomic.AddInt32(&interp.goroutines, 1)
-			if interp.ctx.RunContext != nil {
-				go func() {
-					root := &frame{interp: interp}
-					switch f := fn.(type) {
-					case *ssa.Function:
-						root.pfn = interp.funcs[f]
-					case *closure:
-						root.pfn = f.pfn
-					}
-					defer func() {
-						e := recover()
-						if e != nil {
-							interp.cherror <- PanicError{stack: debugStack(root), Value: e}
-						}
-					}()
-					interp.callDiscardsResult(root, fn, args, instr.Call.Args)
-					atomic.AddInt32(&interp.goroutines, -1)
-				}()
-			} else {
-				go func() {
-					interp.callDiscardsResult(&frame{}, fn, args, instr.Call.Args)
-					atomic.AddInt32(&interp.goroutines, -1)
-				}()
-			}
+			var test string
+			if l := interp.leak; l != nil {
+				test = l.spawn()
+			}
+			var rt *raceThread
+			if r := interp.race; r != nil {
+				rt = r.fork(fr)
+			}
+			var sg *schedG
+			if s := interp.sched; s != nil {
+				sg = s.spawn()
+			}
+			go func() {
+				if sg != nil {
+					interp.sched.start(sg)
+					defer interp.sched.exit(sg)
+				}
+				if d := interp.deadlock; d != nil {
+					defer d.exit(d.enter())
+				}
+				if rt != nil {
+					interp.race.start(rt)
+					defer interp.race.exit(rt)
+				}
+				if l := interp.leak; l != nil {
+					defer l.exit(l.enter(created, test))
+				}
+				defer atomic.AddInt32(&interp.goroutines, -1)
+				interp.goCall(fn, args, instr.Call.Args)
+			}()
 		}
 	case *ssa.Defer:
 		iv, ia, ib := getCallIndex(pfn, &instr.Call)
+		if instr.DeferStack != nil {
+			// defer in range-over-func body push to the enclosing function frame
+			is := pfn.regIndex(instr.DeferStack)
+			return func(fr *frame) {
+				fn, args := fr.interp.prepareCall(fr, &instr.Call, iv, ia, ib)
+				stack := fr.reg(is).(*frame)
+				stack._defer = &_defer{
+					fn:      fn,
+					args:    args,
+					ssaArgs: instr.Call.Args,
+					tail:    stack._defer,
+				}
+			}
+		}
 		return func(fr *frame) {
-			fn, args := interp.prepareCall(fr, &instr.Call, iv, ia, ib)
+			fn, args := fr.interp.prepareCall(fr, &instr.Call, iv, ia, ib)
 			fr._defer = &_defer{
 				fn:      fn,
 				args:    args,
@@ -963,9 +1146,9 @@ func makeInstr(interp *Interp, pfn *function, instr ssa.Instruction) func(fr *fr
 			x := fr.reg(ix)
 			ch := reflect.ValueOf(c)
 			if x == nil {
-				ch.Send(reflect.New(ch.Type().Elem()).Elem())
+				fr.interp.chanSend(fr, ch, reflect.New(ch.Type().Elem()).Elem())
 			} else {
-				ch.Send(reflect.ValueOf(x))
+				fr.interp.chanSend(fr, ch, reflect.ValueOf(x))
 			}
 		}
 	case *ssa.Store:
@@ -1029,7 +1212,7 @@ func makeInstr(interp *Interp, pfn *function, instr ssa.Instruction) func(fr *fr
 				ref.toValue = func() (*types.Var, interface{}, bool) {
 					return v, fr.reg(ix), true
 				}
-				interp.ctx.debugFunc(ref)
+				interp.conf.debugFunc(ref)
 			}
 		}
 		return func(fr *frame) {
@@ -1037,7 +1220,7 @@ func makeInstr(interp *Interp, pfn *function, instr ssa.Instruction) func(fr *fr
 			ref.toValue = func() (*types.Var, interface{}, bool) {
 				return nil, nil, false
 			}
-			interp.ctx.debugFunc(ref)
+			interp.conf.debugFunc(ref)
 		}
 	default:
 		panic(fmt.Errorf("unreachable %T", instr))
@@ -1063,51 +1246,132 @@ var (
 	typFramePtr = reflect.TypeOf((*frame)(nil))
 )
 
+// hasRangeFuncDefers check the function call ssa:deferstack for range-over-func
+// body defers, but the RunDefers instrs is removed by ssa lift.
+func hasRangeFuncDefers(fn *ssa.Function) bool {
+	var deferstack bool
+	for _, b := range fn.Blocks {
+		for _, instr := range b.Instrs {
+			switch instr := instr.(type) {
+			case *ssa.RunDefers:
+				return false
+			case *ssa.Call:
+				if fn, ok := instr.Call.Value.(*ssa.Builtin); ok && fn.Name() == "ssa:deferstack" {
+					deferstack = true
+				}
+			}
+		}
+	}
+	return deferstack
+}
+
+// rangeFuncErrors map the ssa range-over-func check panics to runtime errors.
+var rangeFuncErrors = map[string]string{
+	"yield function called after range loop exit": "range function continued iteration after function for loop body returned false",
+	"iterator call did not preserve panic":        "range function recovered a loop body panic and did not resume panicking",
+}
+
+func rangeFuncError(instr *ssa.Panic) (string, bool) {
+	if comment := instr.Block().Comment; !strings.HasPrefix(comment, "yield-") && !strings.HasPrefix(comment, "rangefunc.") {
+		return "", false
+	}
+	if mi, ok := instr.X.(*ssa.MakeInterface); ok {
+		if c, ok := mi.X.(*ssa.Const); ok && c.Value != nil && c.Value.Kind() == constant.String {
+			err, ok := rangeFuncErrors[constant.StringVal(c.Value)]
+			return err, ok
+		}
+	}
+	return "", false
+}
+
+// makeRangeFuncReturn run defers before return, and reload the named results
+// that may be changed by defers.
+func makeRangeFuncReturn(pfn *function, instr *ssa.Return) func(fr *frame) {
+	n := len(instr.Results)
+	ir := make([]register, n)
+	var reload []func(fr *frame)
+	results := instr.Parent().Signature.Results()
+	for i, v := range instr.Results {
+		ir[i] = pfn.regIndex(v)
+		if load, ok := v.(*ssa.UnOp); ok && load.Op == token.MUL {
+			if alloc, ok := load.X.(*ssa.Alloc); ok && alloc.Comment != "" && alloc.Comment == results.At(i).Name() {
+				reload = append(reload, makeUnOpMUL(pfn, load))
+			}
+		}
+	}
+	return func(fr *frame) {
+		fr.runDefers()
+		for _, fn := range reload {
+			fn(fr)
+		}
+		for i := 0; i < n; i++ {
+			fr.stack[i] = fr.reg(ir[i])
+		}
+		fr.ipc = -1
+	}
+}
+
 func makeCallInstr(pfn *function, interp *Interp, instr ssa.Value, call *ssa.CallCommon) func(fr *frame) {
 	ir := pfn.regIndex(instr)
 	iv, ia, ib := getCallIndex(pfn, call)
+	// push callee on heap if no wrapper use results after call
+	heap := interp.ctx.evalCallFn == nil
 	switch fn := call.Value.(type) {
 	case *ssa.Builtin:
 		fname := fn.Name()
 		return func(fr *frame) {
-			interp.callBuiltinByStack(fr, fname, call.Args, ir, ia)
+			fr.interp.callBuiltinByStack(fr, fname, call.Args, ir, ia)
 		}
 	case *ssa.MakeClosure:
 		ifn := interp.loadFunction(fn.Fn.(*ssa.Function))
 		ia = append(ia, ib...)
-		if ifn.Recover == nil {
+		if heap {
+			return func(fr *frame) {
+				ifn.pushFrame(fr, ir, ia, nil)
+			}
+		}
+		if !ifn.hasRecover() {
 			switch ifn.nres {
 			case 0:
 				return func(fr *frame) {
-					interp.callFunctionByStackNoRecover0(fr, ifn, ir, ia)
+					fr.interp.callFunctionByStackNoRecover0(fr, ifn, ir, ia)
 				}
 			case 1:
 				return func(fr *frame) {
-					interp.callFunctionByStackNoRecover1(fr, ifn, ir, ia)
+					fr.interp.callFunctionByStackNoRecover1(fr, ifn, ir, ia)
 				}
 			default:
 				return func(fr *frame) {
-					interp.callFunctionByStackNoRecoverN(fr, ifn, ir, ia)
+					fr.interp.callFunctionByStackNoRecoverN(fr, ifn, ir, ia)
 				}
 			}
 		}
 		switch ifn.nres {
 		case 0:
 			return func(fr *frame) {
-				interp.callFunctionByStack0(fr, ifn, ir, ia)
+				fr.interp.callFunctionByStack0(fr, ifn, ir, ia)
 			}
 		case 1:
 			return func(fr *frame) {
-				interp.callFunctionByStack1(fr, ifn, ir, ia)
+				fr.interp.callFunctionByStack1(fr, ifn, ir, ia)
 			}
 		default:
 			return func(fr *frame) {
-				interp.callFunctionByStackN(fr, ifn, ir, ia)
+				fr.interp.callFunctionByStackN(fr, ifn, ir, ia)
 			}
 		}
 	case *ssa.Function:
 		// "static func/method call"
+		if interp.ctx.suspends[fn.String()] {
+			return makeSuspendInstr(fn, ia)
+		}
 		if fn.Blocks == nil {
+			if thunk, ok := findExternThunk(interp, fn); ok {
+				nres := fn.Signature.Results().Len()
+				return func(fr *frame) {
+					fr.interp.callThunkByStack(fr, thunk, nres, ir, ia)
+				}
+			}
 			ext, ok := findExternFunc(interp, fn)
 			if !ok {
 				// skip pkg.init
@@ -1118,48 +1382,53 @@ func makeCallInstr(pfn *function, interp *Interp, instr ssa.Value, call *ssa.Cal
 			}
 			if typ := ext.Type(); typ.NumIn() > 0 && typ.In(0) == typFramePtr {
 				return func(fr *frame) {
-					interp.callExternalWithFrameByStack(fr, ext, ir, ia)
+					fr.interp.callExternalWithFrameByStack(fr, ext, ir, ia)
 				}
 			}
 			return func(fr *frame) {
-				interp.callExternalByStack(fr, ext, ir, ia)
+				fr.interp.callExternalByStack(fr, ext, ir, ia)
 			}
 		}
 		ifn := interp.loadFunction(fn)
-		if ifn.Recover == nil {
+		if heap {
+			return func(fr *frame) {
+				ifn.pushFrame(fr, ir, ia, nil)
+			}
+		}
+		if !ifn.hasRecover() {
 			switch ifn.nres {
 			case 0:
 				return func(fr *frame) {
-					interp.callFunctionByStackNoRecover0(fr, ifn, ir, ia)
+					fr.interp.callFunctionByStackNoRecover0(fr, ifn, ir, ia)
 				}
 			case 1:
 				return func(fr *frame) {
-					interp.callFunctionByStackNoRecover1(fr, ifn, ir, ia)
+					fr.interp.callFunctionByStackNoRecover1(fr, ifn, ir, ia)
 				}
 			default:
 				return func(fr *frame) {
-					interp.callFunctionByStackNoRecoverN(fr, ifn, ir, ia)
+					fr.interp.callFunctionByStackNoRecoverN(fr, ifn, ir, ia)
 				}
 			}
 		}
 		switch ifn.nres {
 		case 0:
 			return func(fr *frame) {
-				interp.callFunctionByStack0(fr, ifn, ir, ia)
+				fr.interp.callFunctionByStack0(fr, ifn, ir, ia)
 			}
 		case 1:
 			return func(fr *frame) {
-				interp.callFunctionByStack1(fr, ifn, ir, ia)
+				fr.interp.callFunctionByStack1(fr, ifn, ir, ia)
 			}
 		default:
 			return func(fr *frame) {
-				interp.callFunctionByStackN(fr, ifn, ir, ia)
+				fr.interp.callFunctionByStackN(fr, ifn, ir, ia)
 			}
 		}
 	}
 	// "dynamic method call" // ("invoke" mode)
 	if call.IsInvoke() {
-		return makeCallMethodInstr(interp, instr, call, ir, iv, ia)
+		return makeCallMethodInstr(interp, instr, call, ir, iv, ia, heap)
 	}
 	// dynamic func call
 	typ := interp.preToType(call.Value.Type())
@@ -1170,21 +1439,23 @@ func makeCallInstr(pfn *function, interp *Interp, instr ssa.Value, call *ssa.Cal
 		return func(fr *frame) {
 			fn := fr.reg(iv)
 			v := reflect.ValueOf(fn)
-			interp.callExternalByStack(fr, v, ir, ia)
+			fr.interp.callExternalByStack(fr, v, ir, ia)
 		}
 	}
 	return func(fr *frame) {
 		fn := fr.reg(iv)
 		if fv, n := funcval.Get(fn); n == 1 {
 			c := (*makeFuncVal)(unsafe.Pointer(fv))
-			if c.pfn.Recover == nil {
-				interp.callFunctionByStackNoRecoverWithEnv(fr, c.pfn, ir, ia, c.env)
+			if heap {
+				c.pfn.pushFrame(fr, ir, ia, c.env)
+			} else if !c.pfn.hasRecover() {
+				fr.interp.callFunctionByStackNoRecoverWithEnv(fr, c.pfn, ir, ia, c.env)
 			} else {
-				interp.callFunctionByStackWithEnv(fr, c.pfn, ir, ia, c.env)
+				fr.interp.callFunctionByStackWithEnv(fr, c.pfn, ir, ia, c.env)
 			}
 		} else {
 			v := reflect.ValueOf(fn)
-			interp.callExternalByStack(fr, v, ir, ia)
+			fr.interp.callExternalByStack(fr, v, ir, ia)
 		}
 	}
 }
@@ -1238,28 +1509,34 @@ func (i *Interp) findMethod(typ reflect.Type, mname string) (fn *ssa.Function, o
 	return
 }
 
-func makeCallMethodInstr(interp *Interp, instr ssa.Value, call *ssa.CallCommon, ir register, iv register, ia []register) func(fr *frame) {
+func makeCallMethodInstr(interp *Interp, instr ssa.Value, call *ssa.CallCommon, ir register, iv register, ia []register, heap bool) func(fr *frame) {
 	mname := call.Method.Name()
 	ia = append([]register{iv}, ia...)
-	var found bool
-	var ext reflect.Value
 	return func(fr *frame) {
 		v := fr.reg(iv)
 		rtype := reflect.TypeOf(v)
+		var found bool
+		var ext reflect.Value
 		// find user type method *ssa.Function
 		if mset, ok := interp.msets[rtype]; ok {
 			if fn, ok := mset[mname]; ok {
-				interp.callFunctionByStack(fr, interp.funcs[fn], ir, ia)
+				if heap {
+					interp.funcs[fn].pushFrame(fr, ir, ia, nil)
+				} else {
+					fr.interp.callFunctionByStack(fr, interp.funcs[fn], ir, ia)
+				}
 				return
 			}
 			ext, found = findUserMethod(rtype, mname)
-		} else {
-			ext, found = findExternMethod(rtype, mname)
+		} else if ext, found = findExternMethod(rtype, mname); found {
+			if r := fr.interp.recorder; r != nil {
+				ext = r.method(rtype, mname, ext)
+			}
 		}
 		if !found {
 			panic(fmt.Errorf("no code for method: %v.%v", rtype, mname))
 		}
-		interp.callExternalByStack(fr, ext, ir, ia)
+		fr.interp.callExternalByStack(fr, ext, ir, ia)
 	}
 }
 
@@ -1284,9 +1561,23 @@ func (it *stringIter) next() tuple {
 type mapIter struct {
 	iter *reflect.MapIter
 	ok   bool
+	m    reflect.Value   // map of keys
+	keys []reflect.Value // keys order of deterministic schedule
+	i    int
 }
 
 func (it *mapIter) next() tuple {
+	if it.keys != nil {
+		for it.i < len(it.keys) {
+			k := it.keys[it.i]
+			it.i++
+			// skip key deleted
+			if v := it.m.MapIndex(k); v.IsValid() {
+				return []value{true, k.Interface(), v.Interface()}
+			}
+		}
+		return []value{false, nil, nil}
+	}
 	it.ok = it.iter.Next()
 	if !it.ok {
 		return []value{false, nil, nil}
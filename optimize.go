@@ -0,0 +1,708 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"go/constant"
+	"go/token"
+	"go/types"
+	"math"
+
+	"golang.org/x/tools/go/ssa"
+)
+
+// optimizer run the SSA optimization passes of function before make instrs.
+// The SSA is shared by interps and never changed, the folded and simplified
+// values are recorded to replace the operands, the dead pure instrs and the
+// unreachable blocks by const If are skipped by compile.
+type optimizer struct {
+	fn          *ssa.Function
+	values      map[ssa.Value]ssa.Value // replaced operands
+	unreachable map[*ssa.BasicBlock]bool
+	buf         [32]*ssa.Value
+}
+
+// optimizeFunc optimize the function by level, set the replaced operands,
+// live instrs and referrers of pfn, and the IndexAddr instrs need not check
+// bounds.
+func optimizeFunc(pfn *function, level int) {
+	fn := pfn.Fn
+	if len(fn.Blocks) == 0 {
+		return
+	}
+	opt := &optimizer{fn: fn, values: make(map[ssa.Value]ssa.Value)}
+	opt.reachable()
+	for changed := true; changed; {
+		changed = opt.foldConsts()
+		if opt.reachable() {
+			changed = true
+		}
+		if opt.simplifyPhis() {
+			changed = true
+		}
+	}
+	for v := range opt.values {
+		opt.values[v] = opt.value(v)
+	}
+	pfn.values = opt.values
+	pfn.live, pfn.refs = opt.removeDead()
+	if level >= 2 {
+		pfn.nobounds = opt.elideBounds()
+	}
+}
+
+// value return the operand v replaced by optimizer.
+func (p *function) value(v ssa.Value) ssa.Value {
+	if r, ok := p.values[v]; ok {
+		return r
+	}
+	return v
+}
+
+// referrers return the instrs use v, only the live instrs if optimized.
+func (p *function) referrers(v ssa.Value) []ssa.Instruction {
+	if p.refs != nil && v.Parent() == p.Fn {
+		return p.refs[v]
+	}
+	if refs := v.Referrers(); refs != nil {
+		return *refs
+	}
+	return nil
+}
+
+// blockInstrs return the instrs of block to compile, nil if unreachable.
+func (p *function) blockInstrs(b *ssa.BasicBlock) []ssa.Instruction {
+	if p.live != nil {
+		return p.live[b]
+	}
+	return b.Instrs
+}
+
+// value return the value replace v.
+func (opt *optimizer) value(v ssa.Value) ssa.Value {
+	for {
+		r, ok := opt.values[v]
+		if !ok {
+			return v
+		}
+		v = r
+	}
+}
+
+// replace record the uses of old replaced by v, report changed.
+func (opt *optimizer) replace(old ssa.Value, v ssa.Value) bool {
+	if _, ok := opt.values[old]; ok {
+		return false
+	}
+	if v = opt.value(v); v == old {
+		return false
+	}
+	opt.values[old] = v
+	return true
+}
+
+// constCond return the const Cond of If, nil if not const.
+func (opt *optimizer) constCond(instr *ssa.If) *ssa.Const {
+	if c, ok := opt.value(instr.Cond).(*ssa.Const); ok && c.Value != nil {
+		return c
+	}
+	return nil
+}
+
+// reachable mark the unreachable blocks by const If, report changed.
+func (opt *optimizer) reachable() bool {
+	seen := make(map[*ssa.BasicBlock]bool)
+	var visit func(b *ssa.BasicBlock)
+	visit = func(b *ssa.BasicBlock) {
+		if b == nil || seen[b] {
+			return
+		}
+		seen[b] = true
+		succs := b.Succs
+		if n := len(b.Instrs); n > 0 {
+			if instr, ok := b.Instrs[n-1].(*ssa.If); ok {
+				if c := opt.constCond(instr); c != nil {
+					if constant.BoolVal(c.Value) {
+						succs = succs[:1]
+					} else {
+						succs = succs[1:]
+					}
+				}
+			}
+		}
+		for _, s := range succs {
+			visit(s)
+		}
+	}
+	visit(opt.fn.Blocks[0])
+	visit(opt.fn.Recover)
+	unreachable := make(map[*ssa.BasicBlock]bool)
+	for _, b := range opt.fn.Blocks {
+		if !seen[b] {
+			unreachable[b] = true
+		}
+	}
+	changed := len(unreachable) != len(opt.unreachable)
+	opt.unreachable = unreachable
+	return changed
+}
+
+// liveEdge check the edge from pred to succ may be taken.
+func (opt *optimizer) liveEdge(pred *ssa.BasicBlock, succ *ssa.BasicBlock) bool {
+	if opt.unreachable[pred] {
+		return false
+	}
+	if instr, ok := pred.Instrs[len(pred.Instrs)-1].(*ssa.If); ok {
+		if c := opt.constCond(instr); c != nil {
+			if constant.BoolVal(c.Value) {
+				return pred.Succs[0] == succ
+			}
+			return pred.Succs[1] == succ
+		}
+	}
+	return true
+}
+
+// foldConsts replace the BinOp and Convert of consts by const.
+func (opt *optimizer) foldConsts() (changed bool) {
+	for _, b := range opt.fn.Blocks {
+		if opt.unreachable[b] {
+			continue
+		}
+		for _, instr := range b.Instrs {
+			var c *ssa.Const
+			switch instr := instr.(type) {
+			case *ssa.BinOp:
+				if _, ok := opt.values[instr]; !ok {
+					c = foldBinOp(instr, opt.value(instr.X), opt.value(instr.Y))
+				}
+			case *ssa.Convert:
+				if _, ok := opt.values[instr]; !ok {
+					c = foldConvert(instr, opt.value(instr.X))
+				}
+			}
+			if c != nil && opt.replace(instr.(ssa.Value), c) {
+				changed = true
+			}
+		}
+	}
+	return
+}
+
+// constBasic return the const value and basic type of v.
+func constBasic(v ssa.Value) (constant.Value, *types.Basic, bool) {
+	c, ok := v.(*ssa.Const)
+	if !ok || c.Value == nil {
+		return nil, nil, false
+	}
+	t, ok := c.Type().Underlying().(*types.Basic)
+	if !ok {
+		return nil, nil, false
+	}
+	// the const of integer type may be float or complex value like 1.0
+	cv := c.Value
+	switch {
+	case t.Info()&types.IsInteger != 0:
+		cv = constant.ToInt(cv)
+	case t.Info()&types.IsFloat != 0:
+		cv = constant.ToFloat(cv)
+	}
+	return cv, t, cv.Kind() != constant.Unknown
+}
+
+// constBits return the bits of integer const, the signed is sign-extended.
+func constBits(v constant.Value, t *types.Basic) (uint64, bool) {
+	if t.Info()&types.IsUnsigned != 0 {
+		return constant.Uint64Val(v)
+	}
+	if n, ok := constant.Int64Val(v); ok {
+		return uint64(n), true
+	}
+	if t.Info()&types.IsUntyped != 0 {
+		return constant.Uint64Val(v)
+	}
+	return 0, false
+}
+
+// makeIntConst return the const of bits truncated to integer type t.
+func makeIntConst(bits uint64, t *types.Basic, typ types.Type) *ssa.Const {
+	shift := 64 - uint(unboxSize(unboxKind(types.Typ[t.Kind()]))*8)
+	if t.Info()&types.IsUnsigned != 0 {
+		return ssa.NewConst(constant.MakeUint64(bits<<shift>>shift), typ)
+	}
+	return ssa.NewConst(constant.MakeInt64(int64(bits<<shift)>>shift), typ)
+}
+
+// makeFloatConst return the const of float type t, nil if not finite.
+func makeFloatConst(f float64, t *types.Basic, typ types.Type) *ssa.Const {
+	if t.Kind() == types.Float32 {
+		f = float64(float32(f))
+	}
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil
+	}
+	return ssa.NewConst(constant.MakeFloat64(f), typ)
+}
+
+// foldBinOp return the const of instr by operands vx and vy, nil if not const.
+func foldBinOp(instr *ssa.BinOp, vx ssa.Value, vy ssa.Value) *ssa.Const {
+	x, tx, ok := constBasic(vx)
+	if !ok {
+		return nil
+	}
+	y, ty, ok := constBasic(vy)
+	if !ok {
+		return nil
+	}
+	info := tx.Info()
+	if info&types.IsUntyped != 0 {
+		return nil
+	}
+	switch instr.Op {
+	case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+		var r bool
+		switch {
+		case info&types.IsFloat != 0:
+			fx, _ := constant.Float64Val(x)
+			fy, _ := constant.Float64Val(y)
+			switch instr.Op {
+			case token.EQL:
+				r = fx == fy
+			case token.NEQ:
+				r = fx != fy
+			case token.LSS:
+				r = fx < fy
+			case token.LEQ:
+				r = fx <= fy
+			case token.GTR:
+				r = fx > fy
+			case token.GEQ:
+				r = fx >= fy
+			}
+		case info&(types.IsInteger|types.IsString|types.IsBoolean) != 0:
+			r = constant.Compare(x, instr.Op, y)
+		default:
+			return nil
+		}
+		return ssa.NewConst(constant.MakeBool(r), instr.Type())
+	}
+	switch {
+	case info&types.IsInteger != 0:
+		bx, ok := constBits(x, tx)
+		if !ok {
+			return nil
+		}
+		by, ok := constBits(y, ty)
+		if !ok {
+			return nil
+		}
+		signed := info&types.IsUnsigned == 0
+		var r uint64
+		switch instr.Op {
+		case token.ADD:
+			r = bx + by
+		case token.SUB:
+			r = bx - by
+		case token.MUL:
+			r = bx * by
+		case token.QUO, token.REM:
+			if by == 0 {
+				return nil
+			}
+			switch {
+			case !signed && instr.Op == token.QUO:
+				r = bx / by
+			case !signed:
+				r = bx % by
+			case instr.Op == token.QUO:
+				r = uint64(int64(bx) / int64(by))
+			default:
+				r = uint64(int64(bx) % int64(by))
+			}
+		case token.AND:
+			r = bx & by
+		case token.OR:
+			r = bx | by
+		case token.XOR:
+			r = bx ^ by
+		case token.AND_NOT:
+			r = bx &^ by
+		case token.SHL, token.SHR:
+			if ty.Info()&types.IsUnsigned == 0 && int64(by) < 0 {
+				return nil
+			}
+			switch {
+			case instr.Op == token.SHL:
+				r = bx << by
+			case signed:
+				r = uint64(int64(bx) >> by)
+			default:
+				r = bx >> by
+			}
+		default:
+			return nil
+		}
+		return makeIntConst(r, tx, instr.Type())
+	case info&types.IsFloat != 0:
+		fx, _ := constant.Float64Val(x)
+		fy, _ := constant.Float64Val(y)
+		var r float64
+		switch instr.Op {
+		case token.ADD:
+			r = fx + fy
+		case token.SUB:
+			r = fx - fy
+		case token.MUL:
+			r = fx * fy
+		case token.QUO:
+			r = fx / fy
+		default:
+			return nil
+		}
+		return makeFloatConst(r, tx, instr.Type())
+	case info&types.IsString != 0 && instr.Op == token.ADD:
+		return ssa.NewConst(constant.BinaryOp(x, token.ADD, y), instr.Type())
+	}
+	return nil
+}
+
+// foldConvert return the const of instr by operand vx, nil if not const.
+func foldConvert(instr *ssa.Convert, vx ssa.Value) *ssa.Const {
+	x, tx, ok := constBasic(vx)
+	if !ok {
+		return nil
+	}
+	t, ok := instr.Type().Underlying().(*types.Basic)
+	if !ok {
+		return nil
+	}
+	from, to := tx.Info(), t.Info()
+	switch {
+	case from&types.IsUntyped != 0 || to&types.IsUntyped != 0:
+		return nil
+	case from&types.IsInteger != 0 && to&types.IsInteger != 0:
+		bits, ok := constBits(x, tx)
+		if !ok {
+			return nil
+		}
+		return makeIntConst(bits, t, instr.Type())
+	case from&types.IsInteger != 0 && to&types.IsFloat != 0:
+		bits, ok := constBits(x, tx)
+		if !ok {
+			return nil
+		}
+		if from&types.IsUnsigned != 0 {
+			return makeFloatConst(float64(bits), t, instr.Type())
+		}
+		return makeFloatConst(float64(int64(bits)), t, instr.Type())
+	case from&types.IsFloat != 0 && to&types.IsFloat != 0:
+		f, _ := constant.Float64Val(x)
+		return makeFloatConst(f, t, instr.Type())
+	}
+	return nil
+}
+
+// simplifyPhis replace the phi by the value of all reachable edges.
+func (opt *optimizer) simplifyPhis() (changed bool) {
+	for _, b := range opt.fn.Blocks {
+		if opt.unreachable[b] {
+			continue
+		}
+		for _, instr := range b.Instrs {
+			phi, ok := instr.(*ssa.Phi)
+			if !ok {
+				break
+			}
+			if _, ok := opt.values[phi]; ok {
+				continue
+			}
+			var v ssa.Value
+			for n, e := range phi.Edges {
+				if e = opt.value(e); e == phi || !opt.liveEdge(b.Preds[n], b) {
+					continue
+				}
+				if v == nil {
+					v = e
+				} else if !sameValue(v, e) {
+					v = nil
+					break
+				}
+			}
+			if v != nil && opt.replace(phi, v) {
+				changed = true
+			}
+		}
+	}
+	return
+}
+
+func sameValue(x, y ssa.Value) bool {
+	if x == y {
+		return true
+	}
+	cx, ok := x.(*ssa.Const)
+	if !ok {
+		return false
+	}
+	cy, ok := y.(*ssa.Const)
+	if !ok || !types.Identical(cx.Type(), cy.Type()) {
+		return false
+	}
+	if cx.Value == nil || cy.Value == nil {
+		return cx.Value == nil && cy.Value == nil
+	}
+	return cx.Value.Kind() == cy.Value.Kind() && constant.Compare(cx.Value, token.EQL, cy.Value)
+}
+
+// isPure check the instr has no side effect and not panic.
+func (opt *optimizer) isPure(instr ssa.Instruction) bool {
+	switch instr := instr.(type) {
+	case *ssa.BinOp:
+		// compare interfaces may panic
+		if _, ok := instr.X.Type().Underlying().(*types.Basic); !ok {
+			return false
+		}
+		switch instr.Op {
+		case token.QUO, token.REM:
+			t, ok := instr.Y.Type().Underlying().(*types.Basic)
+			if !ok || t.Info()&types.IsInteger == 0 {
+				return ok
+			}
+			y, _, ok := constBasic(opt.value(instr.Y))
+			return ok && constant.Sign(y) != 0
+		case token.SHL, token.SHR:
+			t, ok := instr.Y.Type().Underlying().(*types.Basic)
+			if ok && t.Info()&types.IsUnsigned != 0 {
+				return true
+			}
+			y, _, ok := constBasic(opt.value(instr.Y))
+			return ok && constant.Sign(y) >= 0
+		}
+		return true
+	case *ssa.UnOp:
+		return instr.Op == token.NOT || instr.Op == token.SUB || instr.Op == token.XOR
+	case *ssa.Phi, *ssa.Convert, *ssa.ChangeType, *ssa.ChangeInterface,
+		*ssa.MakeInterface, *ssa.MakeClosure, *ssa.Extract, *ssa.Field:
+		return true
+	}
+	return false
+}
+
+// removeDead return the live instrs of reachable blocks, the pure instrs not
+// used by live instrs are removed, and the referrers of values by them.
+func (opt *optimizer) removeDead() (map[*ssa.BasicBlock][]ssa.Instruction, map[ssa.Value][]ssa.Instruction) {
+	live := make(map[ssa.Instruction]bool)
+	var work []ssa.Instruction
+	for _, b := range opt.fn.Blocks {
+		if opt.unreachable[b] {
+			continue
+		}
+		for _, instr := range b.Instrs {
+			if !opt.isPure(instr) {
+				live[instr] = true
+				work = append(work, instr)
+			}
+		}
+	}
+	for len(work) > 0 {
+		instr := work[len(work)-1]
+		work = work[:len(work)-1]
+		ops := instr.Operands(opt.buf[:0])
+		if phi, ok := instr.(*ssa.Phi); ok {
+			ops = ops[:0]
+			for n := range phi.Edges {
+				if opt.liveEdge(phi.Block().Preds[n], phi.Block()) {
+					ops = append(ops, &phi.Edges[n])
+				}
+			}
+		}
+		for _, op := range ops {
+			if def, ok := opt.value(*op).(ssa.Instruction); ok && !live[def] {
+				live[def] = true
+				work = append(work, def)
+			}
+		}
+	}
+	blocks := make(map[*ssa.BasicBlock][]ssa.Instruction)
+	refs := make(map[ssa.Value][]ssa.Instruction)
+	for _, b := range opt.fn.Blocks {
+		if opt.unreachable[b] {
+			continue
+		}
+		instrs := make([]ssa.Instruction, 0, len(b.Instrs))
+		for _, instr := range b.Instrs {
+			if !live[instr] {
+				continue
+			}
+			instrs = append(instrs, instr)
+			for _, op := range instr.Operands(opt.buf[:0]) {
+				if *op == nil {
+					continue
+				}
+				if v := opt.value(*op); !hasInstr(refs[v], instr) {
+					refs[v] = append(refs[v], instr)
+				}
+			}
+		}
+		blocks[b] = instrs
+	}
+	return blocks, refs
+}
+
+func hasInstr(instrs []ssa.Instruction, instr ssa.Instruction) bool {
+	for _, v := range instrs {
+		if v == instr {
+			return true
+		}
+	}
+	return false
+}
+
+// elideBounds return the IndexAddr of slice in counted loops, the index is
+// not negative and guarded by index < len(slice).
+func (opt *optimizer) elideBounds() map[ssa.Instruction]bool {
+	nobounds := make(map[ssa.Instruction]bool)
+	for _, b := range opt.fn.Blocks {
+		if opt.unreachable[b] {
+			continue
+		}
+		for _, instr := range b.Instrs {
+			addr, ok := instr.(*ssa.IndexAddr)
+			if !ok {
+				continue
+			}
+			if _, ok := addr.X.Type().Underlying().(*types.Slice); !ok {
+				continue
+			}
+			x, index := opt.value(addr.X), opt.value(addr.Index)
+			if opt.guardedByLen(b, x, index) && opt.lowerBound(index, nil) >= 0 {
+				nobounds[addr] = true
+			}
+		}
+	}
+	return nobounds
+}
+
+// guardedByLen check the block is dominated by branch of x < len(s).
+func (opt *optimizer) guardedByLen(b *ssa.BasicBlock, s ssa.Value, x ssa.Value) bool {
+	for ; b != nil; b = b.Idom() {
+		if len(b.Preds) != 1 {
+			continue
+		}
+		pred := b.Preds[0]
+		instr, ok := pred.Instrs[len(pred.Instrs)-1].(*ssa.If)
+		if !ok {
+			continue
+		}
+		cond, ok := opt.value(instr.Cond).(*ssa.BinOp)
+		if !ok {
+			continue
+		}
+		cx, cy := opt.value(cond.X), opt.value(cond.Y)
+		var lss bool // cond is x < len(s) or len(s) > x
+		switch cond.Op {
+		case token.LSS, token.GEQ:
+			lss = cx == x && opt.isLenOf(cy, s)
+			if cond.Op == token.GEQ {
+				lss = lss && pred.Succs[1] == b
+			} else {
+				lss = lss && pred.Succs[0] == b
+			}
+		case token.GTR, token.LEQ:
+			lss = cy == x && opt.isLenOf(cx, s)
+			if cond.Op == token.LEQ {
+				lss = lss && pred.Succs[1] == b
+			} else {
+				lss = lss && pred.Succs[0] == b
+			}
+		}
+		if lss && pred.Succs[0] != pred.Succs[1] {
+			return true
+		}
+	}
+	return false
+}
+
+func (opt *optimizer) isLenOf(v ssa.Value, s ssa.Value) bool {
+	call, ok := v.(*ssa.Call)
+	if !ok {
+		return false
+	}
+	fn, ok := call.Call.Value.(*ssa.Builtin)
+	return ok && fn.Name() == "len" && len(call.Call.Args) == 1 && opt.value(call.Call.Args[0]) == s
+}
+
+// lowerBound return the lower bound of integer v, the phi in visiting are
+// induction by add non-negative const and not limit the bound.
+func (opt *optimizer) lowerBound(v ssa.Value, visiting map[*ssa.Phi]bool) int64 {
+	if t, ok := v.Type().Underlying().(*types.Basic); ok && t.Info()&types.IsUnsigned != 0 {
+		return 0
+	}
+	switch v := v.(type) {
+	case *ssa.Const:
+		if c, _, ok := constBasic(v); ok && c.Kind() == constant.Int {
+			if n, ok := constant.Int64Val(c); ok {
+				return n
+			}
+		}
+	case *ssa.Call:
+		if fn, ok := v.Call.Value.(*ssa.Builtin); ok && (fn.Name() == "len" || fn.Name() == "cap") {
+			return 0
+		}
+	case *ssa.BinOp:
+		if v.Op != token.ADD {
+			break
+		}
+		x, y := opt.value(v.X), opt.value(v.Y)
+		if _, ok := x.(*ssa.Const); ok {
+			x, y = y, x
+		}
+		c, _, ok := constBasic(y)
+		if !ok || c.Kind() != constant.Int {
+			break
+		}
+		n, ok := constant.Int64Val(c)
+		if !ok || n < 0 {
+			break
+		}
+		lower := opt.lowerBound(x, visiting)
+		if lower == math.MaxInt64 || lower == math.MinInt64 {
+			return lower
+		}
+		return lower + n
+	case *ssa.Phi:
+		if visiting[v] {
+			return math.MaxInt64
+		}
+		if visiting == nil {
+			visiting = make(map[*ssa.Phi]bool)
+		}
+		visiting[v] = true
+		defer delete(visiting, v)
+		lower := int64(math.MaxInt64)
+		for _, e := range v.Edges {
+			if n := opt.lowerBound(opt.value(e), visiting); n < lower {
+				lower = n
+			}
+		}
+		if lower == math.MaxInt64 {
+			return math.MinInt64
+		}
+		return lower
+	}
+	return math.MinInt64
+}
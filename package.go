@@ -21,29 +21,66 @@ import (
 	"log"
 	"reflect"
 	"sort"
+	"sync"
 )
 
 var (
-	registerPkgs = make(map[string]*Package)
+	registerPkgs  = make(map[string]*Package)
+	registerLoads = make(map[string][]func() *Package)
+	registerMutex sync.Mutex
 )
 
 // PackageList return all register packages
 func PackageList() (list []string) {
+	registerMutex.Lock()
+	defer registerMutex.Unlock()
 	for pkg := range registerPkgs {
 		list = append(list, pkg)
 	}
+	for pkg := range registerLoads {
+		if _, ok := registerPkgs[pkg]; !ok {
+			list = append(list, pkg)
+		}
+	}
 	sort.Strings(list)
 	return
 }
 
 // LookupPackage lookup register pkgs
 func LookupPackage(name string) (pkg *Package, ok bool) {
+	registerMutex.Lock()
+	defer registerMutex.Unlock()
+	return lookupPackage(name)
+}
+
+// lookupPackage lookup register pkgs, run lazy loaders on first use.
+func lookupPackage(name string) (pkg *Package, ok bool) {
+	if loads, found := registerLoads[name]; found {
+		delete(registerLoads, name)
+		for _, load := range loads {
+			registerPackage(load())
+		}
+	}
 	pkg, ok = registerPkgs[name]
 	return
 }
 
 // RegisterPackage register pkg
 func RegisterPackage(pkg *Package) {
+	registerMutex.Lock()
+	defer registerMutex.Unlock()
+	registerPackage(pkg)
+}
+
+// RegisterPackageLoader register lazy pkg loader by path,
+// load is called when the package is first imported.
+func RegisterPackageLoader(path string, load func() *Package) {
+	registerMutex.Lock()
+	defer registerMutex.Unlock()
+	registerLoads[path] = append(registerLoads[path], load)
+}
+
+func registerPackage(pkg *Package) {
 	if p, ok := registerPkgs[pkg.Path]; ok {
 		p.merge(pkg)
 		return
@@ -62,18 +99,24 @@ type UntypedConst struct {
 	Value constant.Value
 }
 
+// CallThunk is the typed call thunk of register func generated by export,
+// it call the func by args and store the results to results without reflect.
+type CallThunk func(args []interface{}, results []interface{})
+
 type Package struct {
 	Interfaces    map[string]reflect.Type
 	NamedTypes    map[string]reflect.Type
 	AliasTypes    map[string]reflect.Type
 	Vars          map[string]reflect.Value
 	Funcs         map[string]reflect.Value
+	Thunks        map[string]CallThunk // typed call thunks of Funcs, optional
 	TypedConsts   map[string]TypedConst
 	UntypedConsts map[string]UntypedConst
 	Deps          map[string]string // path -> name
 	Name          string
 	Path          string
 	Source        string
+	GenericSource string // generic declarations import the register package, checked as package Path@generic
 }
 
 // merge same package
@@ -89,10 +132,21 @@ func (p *Package) merge(same *Package) {
 	}
 	for k, v := range same.Funcs {
 		p.Funcs[k] = v
+		if thunk, ok := same.Thunks[k]; ok {
+			if p.Thunks == nil {
+				p.Thunks = make(map[string]CallThunk)
+			}
+			p.Thunks[k] = thunk
+		} else {
+			delete(p.Thunks, k)
+		}
 	}
 	for k, v := range same.UntypedConsts {
 		p.UntypedConsts[k] = v
 	}
+	if same.GenericSource != "" {
+		p.GenericSource = same.GenericSource
+	}
 }
 
 var (
@@ -0,0 +1,344 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"fmt"
+	"go/types"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/visualfc/xtype"
+	"golang.org/x/tools/go/ssa"
+)
+
+// PatchFunc is the replacement wrap the original function, the receiver of
+// method is the first argument. Call orig to invoke the original function.
+type PatchFunc func(orig func(args ...interface{}) []interface{}, args ...interface{}) []interface{}
+
+// Mock replace the function or method of symbol like "main.Add" or
+// "(*main.Client).Do" for interp created later, interpreted or native. The
+// fn is func of the symbol signature with the receiver of method as the first
+// parameter, or PatchFunc to wrap the original. Mock(symbol, nil) remove it.
+func (ctx *Context) Mock(symbol string, fn interface{}) {
+	if fn == nil {
+		delete(ctx.mocks, symbol)
+		return
+	}
+	ctx.mocks[symbol] = fn
+}
+
+// Patch replace the function or method of symbol by fn like Context.Mock,
+// the restore func put back the function before patch. The native function
+// can only patch if Mock by the Context.
+func (i *Interp) Patch(symbol string, fn interface{}) (restore func(), err error) {
+	if i.isNativePatch(symbol) {
+		return i.natives[symbol].patch(symbol, fn)
+	}
+	var pfn *function
+	for f, v := range i.funcs {
+		if f.String() == symbol {
+			pfn = v
+			break
+		}
+	}
+	if pfn != nil {
+		pfn.compile()
+	}
+	if pfn == nil || len(pfn.Instrs) == 0 {
+		return nil, fmt.Errorf("patch %v: %w", symbol, ErrNoFunction)
+	}
+	typ := i.patchType(pfn.Fn)
+	c, err := newPatchCall(symbol, typ, fn)
+	if err != nil {
+		return nil, err
+	}
+	pfn.initPatchEntry(typ)
+	// the inlined call check the function compiled by program
+	counters := []*int32{&pfn.patched}
+	if i.program != nil {
+		if base := i.program.interp.funcs[pfn.Fn]; base != pfn {
+			counters = append(counters, &base.patched)
+		}
+	}
+	remove := pfn.patches.add(c)
+	for _, n := range counters {
+		atomic.AddInt32(n, 1)
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			remove()
+			for _, n := range counters {
+				atomic.AddInt32(n, -1)
+			}
+		})
+	}, nil
+}
+
+// initPatchEntry create the entry of patched function once, the frame of
+// function is allocated by the entry when patched, it call the patches
+// wrap the original function.
+func (p *function) initPatchEntry(typ reflect.Type) {
+	p.patchOnce.Do(func() {
+		orig := p.clone().makeFunction(typ, nil)
+		entry := p.clone()
+		entry.Instrs = []func(*frame){func(fr *frame) {
+			args := make([]reflect.Value, p.narg)
+			for n := range args {
+				if v := fr.stack[p.nres+n]; v == nil {
+					args[n] = reflect.New(typ.In(n)).Elem()
+				} else {
+					args[n] = reflect.ValueOf(v)
+				}
+			}
+			results := p.patches.invoke(args, func(args []reflect.Value) []reflect.Value {
+				return callValue(orig, args)
+			})
+			for n, r := range results {
+				fr.stack[n] = r.Interface()
+			}
+			fr.ipc = -1
+		}}
+		p.patchEntry.Store(entry)
+	})
+}
+
+// isNativePatch check the symbol is native function can patch.
+func (i *Interp) isNativePatch(symbol string) bool {
+	p, ok := i.natives[symbol]
+	return ok && p.ext.IsValid()
+}
+
+// patchType return the func type of fn, the receiver of method is the
+// first parameter.
+func (i *Interp) patchType(fn *ssa.Function) reflect.Type {
+	if p := i.program; p != nil && p.interp != i {
+		return p.interp.patchType(fn)
+	}
+	sig := fn.Signature
+	if recv := sig.Recv(); recv != nil {
+		vars := []*types.Var{recv}
+		for n := 0; n < sig.Params().Len(); n++ {
+			vars = append(vars, sig.Params().At(n))
+		}
+		sig = types.NewSignature(nil, types.NewTuple(vars...), sig.Results(), sig.Variadic())
+	}
+	i.typesMutex.Lock()
+	defer i.typesMutex.Unlock()
+	return i.preToType(sig)
+}
+
+// clone return the function run the same instrs.
+func (p *function) clone() *function {
+	fn := &function{
+		Interp:     p.Interp,
+		Fn:         p.Fn,
+		Main:       p.Main,
+		makeInstr:  p.makeInstr,
+		index:      p.index,
+		instrIndex: p.instrIndex,
+		Instrs:     append([]func(*frame){}, p.Instrs...),
+		Recover:    p.Recover,
+		Blocks:     p.Blocks,
+		stack:      p.stack,
+		unboxed:    p.unboxed,
+		nints:      p.nints,
+		nfloats:    p.nfloats,
+		nobounds:   p.nobounds,
+		values:     p.values,
+		live:       p.live,
+		refs:       p.refs,
+		ssaInstrs:  p.ssaInstrs,
+		base:       p.base,
+		nres:       p.nres,
+		narg:       p.narg,
+		nenv:       p.nenv,
+		compiled:   atomic.LoadInt32(&p.compiled),
+		id:         p.id,
+	}
+	fn.initPool()
+	return fn
+}
+
+// patchCall call the replacement of function.
+type patchCall struct {
+	typ  reflect.Type  // func type, the receiver of method is the first parameter
+	fn   reflect.Value // replacement func of typ, invalid if wrap
+	wrap PatchFunc     // replacement wrap the original
+}
+
+// patchChain is the active patches of function, the last patch wrap the
+// previous. The restore remove the patch in any order.
+type patchChain struct {
+	mu    sync.Mutex
+	calls atomic.Value // []*patchCall, copy on write
+}
+
+func (p *patchChain) load() []*patchCall {
+	calls, _ := p.calls.Load().([]*patchCall)
+	return calls
+}
+
+// add the patch and return the func remove it.
+func (p *patchChain) add(c *patchCall) func() {
+	p.mu.Lock()
+	p.calls.Store(append(append([]*patchCall{}, p.load()...), c))
+	p.mu.Unlock()
+	return func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		calls := p.load()
+		for n, v := range calls {
+			if v == c {
+				p.calls.Store(append(append([]*patchCall{}, calls[:n]...), calls[n+1:]...))
+				break
+			}
+		}
+	}
+}
+
+// invoke call the patches, the first original is ext.
+func (p *patchChain) invoke(args []reflect.Value, ext func([]reflect.Value) []reflect.Value) []reflect.Value {
+	return invokePatches(p.load(), args, ext)
+}
+
+func invokePatches(calls []*patchCall, args []reflect.Value, ext func([]reflect.Value) []reflect.Value) []reflect.Value {
+	if len(calls) == 0 {
+		return ext(args)
+	}
+	return calls[len(calls)-1].call(args, func(args []reflect.Value) []reflect.Value {
+		return invokePatches(calls[:len(calls)-1], args, ext)
+	})
+}
+
+func newPatchCall(symbol string, typ reflect.Type, fn interface{}) (*patchCall, error) {
+	switch f := fn.(type) {
+	case PatchFunc:
+		return &patchCall{typ: typ, wrap: f}, nil
+	case func(func(...interface{}) []interface{}, ...interface{}) []interface{}:
+		return &patchCall{typ: typ, wrap: f}, nil
+	}
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func {
+		return nil, fmt.Errorf("patch %v: %v is not func", symbol, v.Type())
+	}
+	if ft := v.Type(); ft != typ {
+		if ft.NumOut() != typ.NumOut() || ft.IsVariadic() != typ.IsVariadic() || !checkFuncCompatible(typ, ft) {
+			return nil, fmt.Errorf("patch %v: cannot use %v as %v", symbol, ft, typ)
+		}
+		v = xtype.ConvertFunc(v, xtype.TypeOfType(typ))
+	}
+	return &patchCall{typ: typ, fn: v}, nil
+}
+
+// call the replacement by args, orig call the original function.
+func (c *patchCall) call(args []reflect.Value, orig func([]reflect.Value) []reflect.Value) []reflect.Value {
+	if c.wrap == nil {
+		return callValue(c.fn, args)
+	}
+	in := make([]interface{}, len(args))
+	for n, arg := range args {
+		in[n] = arg.Interface()
+	}
+	out := c.wrap(func(args ...interface{}) []interface{} {
+		results := orig(patchValues(args, c.typ.NumIn(), c.typ.In, "arguments"))
+		out := make([]interface{}, len(results))
+		for n, r := range results {
+			out[n] = r.Interface()
+		}
+		return out
+	}, in...)
+	return patchValues(out, c.typ.NumOut(), c.typ.Out, "results")
+}
+
+// patchValues convert values to the types of func parameters or results.
+func patchValues(vs []interface{}, n int, typ func(int) reflect.Type, kind string) []reflect.Value {
+	if len(vs) != n {
+		panic(plainError(fmt.Sprintf("patch func got %v %v, want %v", len(vs), kind, n)))
+	}
+	rs := make([]reflect.Value, n)
+	for i, v := range vs {
+		t := typ(i)
+		if v == nil {
+			rs[i] = reflect.New(t).Elem()
+			continue
+		}
+		rv := reflect.ValueOf(v)
+		if rv.Type() != t {
+			rv = rv.Convert(t)
+		}
+		rs[i] = rv
+	}
+	return rs
+}
+
+func callValue(fn reflect.Value, args []reflect.Value) []reflect.Value {
+	if fn.Type().IsVariadic() {
+		return fn.CallSlice(args)
+	}
+	return fn.Call(args)
+}
+
+// nativePatch is the patchable native function mocked by Context.
+type nativePatch struct {
+	ext     reflect.Value // original native function
+	patches patchChain
+}
+
+// wrap the native function call the patch if exists.
+func (p *nativePatch) wrap(ext reflect.Value) reflect.Value {
+	p.ext = ext
+	typ := ext.Type()
+	withFrame := typ.NumIn() > 0 && typ.In(0) == typFramePtr
+	return reflect.MakeFunc(typ, func(in []reflect.Value) []reflect.Value {
+		calls := p.patches.load()
+		if len(calls) == 0 {
+			return callValue(ext, in)
+		}
+		if withFrame {
+			fr := in[0]
+			return invokePatches(calls, in[1:], func(args []reflect.Value) []reflect.Value {
+				return callValue(ext, append([]reflect.Value{fr}, args...))
+			})
+		}
+		return invokePatches(calls, in, func(args []reflect.Value) []reflect.Value {
+			return callValue(ext, args)
+		})
+	})
+}
+
+func (p *nativePatch) patch(symbol string, fn interface{}) (func(), error) {
+	typ := p.ext.Type()
+	if typ.NumIn() > 0 && typ.In(0) == typFramePtr {
+		in := make([]reflect.Type, typ.NumIn()-1)
+		for n := range in {
+			in[n] = typ.In(n + 1)
+		}
+		out := make([]reflect.Type, typ.NumOut())
+		for n := range out {
+			out[n] = typ.Out(n)
+		}
+		typ = reflect.FuncOf(in, out, typ.IsVariadic())
+	}
+	c, err := newPatchCall(symbol, typ, fn)
+	if err != nil {
+		return nil, err
+	}
+	return p.patches.add(c), nil
+}
@@ -0,0 +1,25 @@
+// export by github.com/goplus/igop/cmd/qexp
+
+//go:build go1.23
+// +build go1.23
+
+package iter
+
+import (
+	_ "iter"
+
+	"github.com/goplus/igop"
+)
+
+func init() {
+	igop.RegisterPackage(&igop.Package{
+		Name: "iter",
+		Path: "iter",
+		Deps: map[string]string{
+			"runtime": "runtime",
+		},
+		Source: source,
+	})
+}
+
+var source = "package iter\n\nimport \"runtime\"\n\ntype Seq[V any] func(yield func(V) bool)\n\ntype Seq2[K, V any] func(yield func(K, V) bool)\n\ntype coro struct {\n\tf      func(*coro)\n\tresume chan struct{}\n\tyield  chan struct{}\n\tinside bool\n}\n\nfunc newcoro(f func(*coro)) *coro {\n\treturn &coro{f: f}\n}\n\nfunc coroswitch(c *coro) {\n\tif c.inside {\n\t\tc.inside = false\n\t\tc.yield <- struct{}{}\n\t\t<-c.resume\n\t\treturn\n\t}\n\tc.inside = true\n\tif c.resume == nil {\n\t\tc.resume = make(chan struct{})\n\t\tc.yield = make(chan struct{})\n\t\tgo func() {\n\t\t\tc.f(c)\n\t\t\tc.inside = false\n\t\t\tc.yield <- struct{}{}\n\t\t}()\n\t} else {\n\t\tc.resume <- struct{}{}\n\t}\n\t<-c.yield\n}\n\nfunc Pull[V any](seq Seq[V]) (next func() (V, bool), stop func()) {\n\tvar pull struct {\n\t\tv          V\n\t\tok         bool\n\t\tdone       bool\n\t\tyieldNext  bool\n\t\tseqDone    bool\n\t\tpanicValue any\n\t}\n\tc := newcoro(func(c *coro) {\n\t\tif pull.done {\n\t\t\treturn\n\t\t}\n\t\tyield := func(v1 V) bool {\n\t\t\tif pull.done {\n\t\t\t\treturn false\n\t\t\t}\n\t\t\tif !pull.yieldNext {\n\t\t\t\tpanic(\"iter.Pull: yield called again before next\")\n\t\t\t}\n\t\t\tpull.yieldNext = false\n\t\t\tpull.v, pull.ok = v1, true\n\t\t\tcoroswitch(c)\n\t\t\treturn !pull.done\n\t\t}\n\t\tdefer func() {\n\t\t\tif p := recover(); p != nil {\n\t\t\t\tpull.panicValue = p\n\t\t\t} else if !pull.seqDone {\n\t\t\t\tpull.panicValue = goexitPanicValue\n\t\t\t}\n\t\t\tpull.done = true\n\t\t}()\n\t\tseq(yield)\n\t\tvar v0 V\n\t\tpull.v, pull.ok = v0, false\n\t\tpull.seqDone = true\n\t})\n\tnext = func() (v1 V, ok1 bool) {\n\t\tif pull.done {\n\t\t\treturn\n\t\t}\n\t\tif pull.yieldNext {\n\t\t\tpanic(\"iter.Pull: next called again before yield\")\n\t\t}\n\t\tpull.yieldNext = true\n\t\tcoroswitch(c)\n\t\tif pull.panicValue != nil {\n\t\t\tif pull.panicValue == goexitPanicValue {\n\t\t\t\truntime.Goexit()\n\t\t\t} else {\n\t\t\t\tpanic(pull.panicValue)\n\t\t\t}\n\t\t}\n\t\treturn pull.v, pull.ok\n\t}\n\tstop = func() {\n\t\tif !pull.done {\n\t\t\tpull.done = true\n\t\t\tcoroswitch(c)\n\t\t\tif pull.panicValue != nil {\n\t\t\t\tif pull.panicValue == goexitPanicValue {\n\t\t\t\t\truntime.Goexit()\n\t\t\t\t} else {\n\t\t\t\t\tpanic(pull.panicValue)\n\t\t\t\t}\n\t\t\t}\n\t\t}\n\t}\n\treturn next, stop\n}\n\nfunc Pull2[K, V any](seq Seq2[K, V]) (next func() (K, V, bool), stop func()) {\n\tvar pull struct {\n\t\tk          K\n\t\tv          V\n\t\tok         bool\n\t\tdone       bool\n\t\tyieldNext  bool\n\t\tseqDone    bool\n\t\tpanicValue any\n\t}\n\tc := newcoro(func(c *coro) {\n\t\tif pull.done {\n\t\t\treturn\n\t\t}\n\t\tyield := func(k1 K, v1 V) bool {\n\t\t\tif pull.done {\n\t\t\t\treturn false\n\t\t\t}\n\t\t\tif !pull.yieldNext {\n\t\t\t\tpanic(\"iter.Pull2: yield called again before next\")\n\t\t\t}\n\t\t\tpull.yieldNext = false\n\t\t\tpull.k, pull.v, pull.ok = k1, v1, true\n\t\t\tcoroswitch(c)\n\t\t\treturn !pull.done\n\t\t}\n\t\tdefer func() {\n\t\t\tif p := recover(); p != nil {\n\t\t\t\tpull.panicValue = p\n\t\t\t} else if !pull.seqDone {\n\t\t\t\tpull.panicValue = goexitPanicValue\n\t\t\t}\n\t\t\tpull.done = true\n\t\t}()\n\t\tseq(yield)\n\t\tvar k0 K\n\t\tvar v0 V\n\t\tpull.k, pull.v, pull.ok = k0, v0, false\n\t\tpull.seqDone = true\n\t})\n\tnext = func() (k1 K, v1 V, ok1 bool) {\n\t\tif pull.done {\n\t\t\treturn\n\t\t}\n\t\tif pull.yieldNext {\n\t\t\tpanic(\"iter.Pull2: next called again before yield\")\n\t\t}\n\t\tpull.yieldNext = true\n\t\tcoroswitch(c)\n\t\tif pull.panicValue != nil {\n\t\t\tif pull.panicValue == goexitPanicValue {\n\t\t\t\truntime.Goexit()\n\t\t\t} else {\n\t\t\t\tpanic(pull.panicValue)\n\t\t\t}\n\t\t}\n\t\treturn pull.k, pull.v, pull.ok\n\t}\n\tstop = func() {\n\t\tif !pull.done {\n\t\t\tpull.done = true\n\t\t\tcoroswitch(c)\n\t\t\tif pull.panicValue != nil {\n\t\t\t\tif pull.panicValue == goexitPanicValue {\n\t\t\t\t\truntime.Goexit()\n\t\t\t\t} else {\n\t\t\t\t\tpanic(pull.panicValue)\n\t\t\t\t}\n\t\t\t}\n\t\t}\n\t}\n\treturn next, stop\n}\n\nvar goexitPanicValue any = new(int)\n"
@@ -0,0 +1,67 @@
+// export by github.com/goplus/igop/cmd/qexp
+
+//go:build go1.22
+// +build go1.22
+
+package rand
+
+import (
+	q "math/rand/v2"
+
+	"reflect"
+
+	"github.com/goplus/igop"
+)
+
+func init() {
+	igop.RegisterPackage(&igop.Package{
+		Name: "rand",
+		Path: "math/rand/v2",
+		Deps: map[string]string{
+			"errors":               "errors",
+			"internal/chacha8rand": "chacha8rand",
+			"math":                 "math",
+			"math/bits":            "bits",
+			"unsafe":               "unsafe",
+		},
+		Interfaces: map[string]reflect.Type{
+			"Source": reflect.TypeOf((*q.Source)(nil)).Elem(),
+		},
+		NamedTypes: map[string]reflect.Type{
+			"ChaCha8": reflect.TypeOf((*q.ChaCha8)(nil)).Elem(),
+			"PCG":     reflect.TypeOf((*q.PCG)(nil)).Elem(),
+			"Rand":    reflect.TypeOf((*q.Rand)(nil)).Elem(),
+			"Zipf":    reflect.TypeOf((*q.Zipf)(nil)).Elem(),
+		},
+		AliasTypes: map[string]reflect.Type{},
+		Vars:       map[string]reflect.Value{},
+		Funcs: map[string]reflect.Value{
+			"ExpFloat64":  reflect.ValueOf(q.ExpFloat64),
+			"Float32":     reflect.ValueOf(q.Float32),
+			"Float64":     reflect.ValueOf(q.Float64),
+			"Int":         reflect.ValueOf(q.Int),
+			"Int32":       reflect.ValueOf(q.Int32),
+			"Int32N":      reflect.ValueOf(q.Int32N),
+			"Int64":       reflect.ValueOf(q.Int64),
+			"Int64N":      reflect.ValueOf(q.Int64N),
+			"IntN":        reflect.ValueOf(q.IntN),
+			"New":         reflect.ValueOf(q.New),
+			"NewChaCha8":  reflect.ValueOf(q.NewChaCha8),
+			"NewPCG":      reflect.ValueOf(q.NewPCG),
+			"NewZipf":     reflect.ValueOf(q.NewZipf),
+			"NormFloat64": reflect.ValueOf(q.NormFloat64),
+			"Perm":        reflect.ValueOf(q.Perm),
+			"Shuffle":     reflect.ValueOf(q.Shuffle),
+			"Uint32":      reflect.ValueOf(q.Uint32),
+			"Uint32N":     reflect.ValueOf(q.Uint32N),
+			"Uint64":      reflect.ValueOf(q.Uint64),
+			"Uint64N":     reflect.ValueOf(q.Uint64N),
+			"UintN":       reflect.ValueOf(q.UintN),
+		},
+		TypedConsts:   map[string]igop.TypedConst{},
+		UntypedConsts: map[string]igop.UntypedConst{},
+		GenericSource: generic,
+	})
+}
+
+var generic = "package rand\n\nimport (\n\t_q \"math/rand/v2\"\n)\n\nfunc N[Int intType](n Int) Int {\n\tif n <= 0 {\n\t\tpanic(\"invalid argument to N\")\n\t}\n\treturn Int(_q.Uint64N(uint64(n)))\n}\n\ntype intType interface {\n\t~int | ~int8 | ~int16 | ~int32 | ~int64 |\n\t\t~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr\n}\n"
@@ -0,0 +1,394 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"bytes"
+	"fmt"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// PackageDiff is the difference of register package and the exported API
+// of the installed package.
+type PackageDiff struct {
+	Path    string
+	Missing []string // exported by package, not registered
+	Extra   []string // registered, not exported by package
+	Changed []string // registered with different kind, type or value
+}
+
+// IsEmpty report the register package is same as the installed package.
+func (d *PackageDiff) IsEmpty() bool {
+	return len(d.Missing) == 0 && len(d.Extra) == 0 && len(d.Changed) == 0
+}
+
+func (d *PackageDiff) String() string {
+	var buf bytes.Buffer
+	for _, v := range d.Missing {
+		fmt.Fprintf(&buf, "missing %v\n", v)
+	}
+	for _, v := range d.Extra {
+		fmt.Fprintf(&buf, "extra %v\n", v)
+	}
+	for _, v := range d.Changed {
+		fmt.Fprintf(&buf, "changed %v\n", v)
+	}
+	return buf.String()
+}
+
+// DiffPackage compare the register package with the exported API of tpkg.
+// Generic declarations are skipped, they are interpreted by package source.
+func DiffPackage(pkg *Package, tpkg *types.Package) *PackageDiff {
+	d := &PackageDiff{Path: tpkg.Path()}
+	found := make(map[string]bool)
+	scope := tpkg.Scope()
+	for _, name := range scope.Names() {
+		if !token.IsExported(name) {
+			continue
+		}
+		obj := scope.Lookup(name)
+		if hasTypeParam(obj.Type()) {
+			continue
+		}
+		found[name] = true
+		d.diffObject(pkg, obj)
+	}
+	for _, name := range registerNames(pkg) {
+		if !found[name] {
+			d.Extra = append(d.Extra, name)
+		}
+	}
+	sort.Strings(d.Missing)
+	sort.Strings(d.Extra)
+	sort.Strings(d.Changed)
+	return d
+}
+
+func (d *PackageDiff) diffObject(pkg *Package, obj types.Object) {
+	name := obj.Name()
+	kind := registerKind(pkg, name)
+	switch obj := obj.(type) {
+	case *types.Const:
+		isUntyped := strings.HasPrefix(obj.Type().String(), "untyped ")
+		switch {
+		case isUntyped && kind == "untyped const":
+			c := pkg.UntypedConsts[name]
+			if c.Typ != obj.Type().String() {
+				d.changed(name, "type", c.Typ, obj.Type().String())
+			} else if !sameConst(c.Value, obj.Val()) {
+				d.changed(name, "value", c.Value, obj.Val())
+			}
+		case !isUntyped && kind == "typed const":
+			c := pkg.TypedConsts[name]
+			if !sameType(c.Typ.String(), obj.Type()) {
+				d.changed(name, "type", c.Typ, typeString(obj.Type()))
+			} else if !sameConst(c.Value, obj.Val()) {
+				d.changed(name, "value", c.Value, obj.Val())
+			}
+		case isUntyped:
+			d.kind(name, kind, "untyped const")
+		default:
+			d.kind(name, kind, "typed const")
+		}
+	case *types.Var:
+		if kind != "var" {
+			d.kind(name, kind, "var")
+		} else if rt := pkg.Vars[name].Type().Elem(); !sameType(rt.String(), obj.Type()) {
+			d.changed(name, "type", rt, typeString(obj.Type()))
+		}
+	case *types.Func:
+		if kind != "func" {
+			d.kind(name, kind, "func")
+		} else if rt := pkg.Funcs[name].Type(); !sameType(rt.String(), obj.Type()) {
+			d.changed(name, "type", rt, typeString(obj.Type()))
+		}
+	case *types.TypeName:
+		switch {
+		case obj.IsAlias():
+			if kind != "alias" {
+				d.kind(name, kind, "alias")
+			} else if rt := pkg.AliasTypes[name]; !sameType(rt.String(), obj.Type()) {
+				d.changed(name, "type", rt, typeString(obj.Type()))
+			}
+		case types.IsInterface(obj.Type()):
+			if kind != "interface" {
+				d.kind(name, kind, "interface")
+			} else {
+				d.methods(name, pkg.Interfaces[name], obj.Type())
+			}
+		default:
+			if kind != "type" {
+				d.kind(name, kind, "type")
+			} else {
+				rt := pkg.NamedTypes[name]
+				d.methods(name, reflect.PtrTo(rt), types.NewPointer(obj.Type()))
+			}
+		}
+	}
+}
+
+func (d *PackageDiff) kind(name string, kind string, want string) {
+	if kind == "" {
+		d.Missing = append(d.Missing, want+" "+name)
+	} else {
+		d.changed(name, "kind", kind, want)
+	}
+}
+
+func (d *PackageDiff) changed(name string, what string, reg interface{}, want interface{}) {
+	d.Changed = append(d.Changed, fmt.Sprintf("%v %v: register %v, want %v", name, what, reg, want))
+}
+
+// methods compare the exported method names of type.
+func (d *PackageDiff) methods(name string, rt reflect.Type, typ types.Type) {
+	reg := make(map[string]bool)
+	for i := 0; i < rt.NumMethod(); i++ {
+		if m := rt.Method(i); token.IsExported(m.Name) {
+			reg[m.Name] = true
+		}
+	}
+	mset := types.NewMethodSet(typ)
+	for i := 0; i < mset.Len(); i++ {
+		m := mset.At(i).Obj()
+		if !m.Exported() {
+			continue
+		}
+		if !reg[m.Name()] {
+			d.Missing = append(d.Missing, "method "+name+"."+m.Name())
+		}
+		delete(reg, m.Name())
+	}
+	for m := range reg {
+		d.Extra = append(d.Extra, "method "+name+"."+m)
+	}
+}
+
+func registerKind(pkg *Package, name string) string {
+	if _, ok := pkg.UntypedConsts[name]; ok {
+		return "untyped const"
+	}
+	if _, ok := pkg.TypedConsts[name]; ok {
+		return "typed const"
+	}
+	if _, ok := pkg.Vars[name]; ok {
+		return "var"
+	}
+	if _, ok := pkg.Funcs[name]; ok {
+		return "func"
+	}
+	if _, ok := pkg.AliasTypes[name]; ok {
+		return "alias"
+	}
+	if _, ok := pkg.Interfaces[name]; ok {
+		return "interface"
+	}
+	if _, ok := pkg.NamedTypes[name]; ok {
+		return "type"
+	}
+	return ""
+}
+
+func registerNames(pkg *Package) (names []string) {
+	for k := range pkg.UntypedConsts {
+		names = append(names, k)
+	}
+	for k := range pkg.TypedConsts {
+		names = append(names, k)
+	}
+	for k := range pkg.Vars {
+		names = append(names, k)
+	}
+	for k := range pkg.Funcs {
+		names = append(names, k)
+	}
+	for k := range pkg.AliasTypes {
+		names = append(names, k)
+	}
+	for k := range pkg.Interfaces {
+		names = append(names, k)
+	}
+	for k := range pkg.NamedTypes {
+		names = append(names, k)
+	}
+	return
+}
+
+func sameConst(v1, v2 constant.Value) bool {
+	if v1 == nil || v2 == nil || v1.Kind() == constant.Unknown || v2.Kind() == constant.Unknown {
+		return v1 == v2
+	}
+	if v1.Kind() != v2.Kind() {
+		switch {
+		case v1.Kind() == constant.Complex || v2.Kind() == constant.Complex:
+			v1, v2 = constant.ToComplex(v1), constant.ToComplex(v2)
+		case v1.Kind() == constant.Float || v2.Kind() == constant.Float:
+			v1, v2 = constant.ToFloat(v1), constant.ToFloat(v2)
+		default:
+			return false
+		}
+	}
+	return constant.Compare(v1, token.EQL, v2)
+}
+
+func sameType(rt string, typ types.Type) bool {
+	return rt == typeString(typ)
+}
+
+// typeString return the types.Type string like reflect.Type.String.
+func typeString(typ types.Type) string {
+	var buf bytes.Buffer
+	writeType(&buf, typ)
+	return buf.String()
+}
+
+func writeType(buf *bytes.Buffer, typ types.Type) {
+	switch t := typ.(type) {
+	case *types.Basic:
+		if t.Kind() == types.UnsafePointer {
+			buf.WriteString("unsafe.Pointer")
+		} else {
+			// byte => uint8, rune => int32
+			buf.WriteString(types.Typ[t.Kind()].Name())
+		}
+	case *types.Named:
+		obj := t.Obj()
+		if obj.Pkg() != nil {
+			buf.WriteString(obj.Pkg().Name())
+			buf.WriteByte('.')
+		}
+		buf.WriteString(obj.Name())
+		if targs := namedTypeArgs(t); len(targs) > 0 {
+			buf.WriteByte('[')
+			for i, targ := range targs {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				writeType(buf, targ)
+			}
+			buf.WriteByte(']')
+		}
+	case *types.Pointer:
+		buf.WriteByte('*')
+		writeType(buf, t.Elem())
+	case *types.Slice:
+		buf.WriteString("[]")
+		writeType(buf, t.Elem())
+	case *types.Array:
+		fmt.Fprintf(buf, "[%v]", t.Len())
+		writeType(buf, t.Elem())
+	case *types.Map:
+		buf.WriteString("map[")
+		writeType(buf, t.Key())
+		buf.WriteByte(']')
+		writeType(buf, t.Elem())
+	case *types.Chan:
+		switch t.Dir() {
+		case types.SendRecv:
+			buf.WriteString("chan ")
+		case types.SendOnly:
+			buf.WriteString("chan<- ")
+		case types.RecvOnly:
+			buf.WriteString("<-chan ")
+		}
+		writeType(buf, t.Elem())
+	case *types.Signature:
+		buf.WriteString("func")
+		writeSignature(buf, t)
+	case *types.Struct:
+		if t.NumFields() == 0 {
+			buf.WriteString("struct {}")
+			return
+		}
+		buf.WriteString("struct {")
+		for i := 0; i < t.NumFields(); i++ {
+			if i > 0 {
+				buf.WriteByte(';')
+			}
+			buf.WriteByte(' ')
+			f := t.Field(i)
+			if !f.Embedded() {
+				buf.WriteString(f.Name())
+				buf.WriteByte(' ')
+			}
+			writeType(buf, f.Type())
+			if tag := t.Tag(i); tag != "" {
+				fmt.Fprintf(buf, " %q", tag)
+			}
+		}
+		buf.WriteString(" }")
+	case *types.Interface:
+		if t.NumMethods() == 0 {
+			buf.WriteString("interface {}")
+			return
+		}
+		buf.WriteString("interface {")
+		for i := 0; i < t.NumMethods(); i++ {
+			if i > 0 {
+				buf.WriteByte(';')
+			}
+			buf.WriteByte(' ')
+			m := t.Method(i)
+			buf.WriteString(m.Name())
+			writeSignature(buf, m.Type().(*types.Signature))
+		}
+		buf.WriteString(" }")
+	default:
+		if typ, ok := unalias(typ); ok {
+			writeType(buf, typ)
+			return
+		}
+		buf.WriteString(typ.String())
+	}
+}
+
+func writeSignature(buf *bytes.Buffer, sig *types.Signature) {
+	buf.WriteByte('(')
+	params := sig.Params()
+	for i := 0; i < params.Len(); i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		if sig.Variadic() && i == params.Len()-1 {
+			buf.WriteString("...")
+			writeType(buf, params.At(i).Type().(*types.Slice).Elem())
+		} else {
+			writeType(buf, params.At(i).Type())
+		}
+	}
+	buf.WriteByte(')')
+	results := sig.Results()
+	switch results.Len() {
+	case 0:
+	case 1:
+		buf.WriteByte(' ')
+		writeType(buf, results.At(0).Type())
+	default:
+		buf.WriteString(" (")
+		for i := 0; i < results.Len(); i++ {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			writeType(buf, results.At(i).Type())
+		}
+		buf.WriteByte(')')
+	}
+}
@@ -0,0 +1,390 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"bytes"
+	"fmt"
+	"go/constant"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	xconst "github.com/goplus/igop/constant"
+)
+
+// SymbolKind is the kind of register package symbol.
+type SymbolKind int
+
+const (
+	SymbolConst     SymbolKind = iota // const
+	SymbolVar                         // var
+	SymbolFunc                        // func
+	SymbolType                        // named type
+	SymbolInterface                   // interface type
+	SymbolAlias                       // alias type
+)
+
+func (k SymbolKind) String() string {
+	switch k {
+	case SymbolConst:
+		return "const"
+	case SymbolVar:
+		return "var"
+	case SymbolFunc:
+		return "func"
+	case SymbolType, SymbolInterface, SymbolAlias:
+		return "type"
+	}
+	return "invalid"
+}
+
+// Symbol is the metadata of register package symbol.
+type Symbol struct {
+	Kind     SymbolKind
+	Pkg      string           // package name
+	Name     string           // symbol name
+	Type     reflect.Type     // var/func/type, nil for untyped const
+	TypeName string           // const type name, eg "untyped int"
+	Value    constant.Value   // const value
+	Methods  []reflect.Method // method set of named type (T then *T), methods of interface
+}
+
+// Signature return the func or method declaration.
+func (s *Symbol) Signature() string {
+	var buf bytes.Buffer
+	switch s.Kind {
+	case SymbolFunc:
+		writeFunc(&buf, s.Name, s.Type)
+	case SymbolConst:
+		if s.Type == nil {
+			fmt.Fprintf(&buf, "const %v = %v", s.Name, xconst.ExactConstant(s.Value))
+		} else {
+			fmt.Fprintf(&buf, "const %v %v = %v", s.Name, s.TypeName, xconst.ExactConstant(s.Value))
+		}
+	case SymbolVar:
+		fmt.Fprintf(&buf, "var %v %v", s.Name, s.Type)
+	case SymbolInterface:
+		fmt.Fprintf(&buf, "type %v interface", s.Name)
+	case SymbolAlias:
+		fmt.Fprintf(&buf, "type %v = %v", s.Name, s.Type)
+	default:
+		fmt.Fprintf(&buf, "type %v %v", s.Name, s.Type.Kind())
+	}
+	return buf.String()
+}
+
+// String return the full declaration of symbol, include struct fields and methods.
+func (s *Symbol) String() string {
+	var buf bytes.Buffer
+	name := s.Pkg + "." + s.Name
+	switch s.Kind {
+	case SymbolConst:
+		fmt.Fprintf(&buf, "const %v %v = %v", name, s.TypeName, xconst.ExactConstant(s.Value))
+	case SymbolVar:
+		fmt.Fprintf(&buf, "var %v %v", name, s.Type)
+	case SymbolFunc:
+		writeFunc(&buf, name, s.Type)
+	case SymbolInterface:
+		writeInterface(&buf, name, s.Type)
+		buf.WriteByte('\n')
+	case SymbolAlias:
+		fmt.Fprintf(&buf, "type %v = %v\n", name, s.Type)
+	default:
+		if s.Type.Kind() == reflect.Struct {
+			writeStruct(&buf, name, s.Type)
+			buf.WriteByte('\n')
+		} else {
+			fmt.Fprintf(&buf, "type %v %v\n", name, s.Type.Kind())
+		}
+		for _, m := range s.Methods {
+			writeMethod(&buf, m)
+			buf.WriteByte('\n')
+		}
+	}
+	return buf.String()
+}
+
+// PackageInfo is the metadata of register package.
+type PackageInfo struct {
+	Name    string
+	Path    string
+	Deps    []string  // sorted import paths
+	Symbols []*Symbol // sorted by kind and name
+}
+
+// LookupPackageInfo return the metadata of register package.
+func LookupPackageInfo(path string) (*PackageInfo, bool) {
+	pkg, ok := LookupPackage(path)
+	if !ok {
+		return nil, false
+	}
+	return NewPackageInfo(pkg), true
+}
+
+// LookupSymbol breaks str apart into a register package, symbol and method,
+// the package may be a full import path contains periods or last path
+// elements of a register package.
+// fmt.Println => fmt Println
+// gopkg.in/yaml.v2.Marshal => gopkg.in/yaml.v2 Marshal
+func LookupSymbol(str string) (pkg, symbol string, method string, found bool) {
+	var dir string
+	if i := strings.LastIndex(str, "/"); i >= 0 {
+		dir, str = str[:i+1], str[i+1:]
+	}
+	elem := strings.Split(str, ".")
+	for n := len(elem); n > 0 && n+2 >= len(elem); n-- {
+		pkg, found = FindPackage(dir + strings.Join(elem[:n], "."))
+		if !found {
+			continue
+		}
+		if n < len(elem) {
+			symbol = elem[n]
+		}
+		if n+1 < len(elem) {
+			method = elem[n+1]
+		}
+		return
+	}
+	return
+}
+
+// FindPackage lookup register package by path or last path elements.
+func FindPackage(name string) (pkg string, found bool) {
+	list := PackageList()
+	for _, v := range list {
+		if name == v {
+			return v, true
+		}
+	}
+	for _, v := range list {
+		if strings.HasSuffix(v, "/"+name) {
+			return v, true
+		}
+	}
+	return
+}
+
+// NewPackageInfo return the metadata of pkg.
+func NewPackageInfo(pkg *Package) *PackageInfo {
+	info := &PackageInfo{Name: pkg.Name, Path: pkg.Path}
+	for dep := range pkg.Deps {
+		info.Deps = append(info.Deps, dep)
+	}
+	sort.Strings(info.Deps)
+	var list []*Symbol
+	add := func(s *Symbol) {
+		s.Pkg = pkg.Name
+		list = append(list, s)
+	}
+	for name, c := range pkg.UntypedConsts {
+		add(&Symbol{Kind: SymbolConst, Name: name, TypeName: c.Typ, Value: c.Value})
+	}
+	for name, c := range pkg.TypedConsts {
+		add(&Symbol{Kind: SymbolConst, Name: name, Type: c.Typ, TypeName: c.Typ.String(), Value: c.Value})
+	}
+	for name, v := range pkg.Vars {
+		add(&Symbol{Kind: SymbolVar, Name: name, Type: v.Type().Elem()})
+	}
+	for name, v := range pkg.Funcs {
+		add(&Symbol{Kind: SymbolFunc, Name: name, Type: v.Type()})
+	}
+	for name, t := range pkg.NamedTypes {
+		add(&Symbol{Kind: SymbolType, Name: name, Type: t, Methods: methodSet(t)})
+	}
+	for name, t := range pkg.Interfaces {
+		var methods []reflect.Method
+		for i := 0; i < t.NumMethod(); i++ {
+			methods = append(methods, t.Method(i))
+		}
+		add(&Symbol{Kind: SymbolInterface, Name: name, Type: t, Methods: methods})
+	}
+	for name, t := range pkg.AliasTypes {
+		add(&Symbol{Kind: SymbolAlias, Name: name, Type: t})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		ki, kj := symbolOrder(list[i]), symbolOrder(list[j])
+		if ki != kj {
+			return ki < kj
+		}
+		return list[i].Name < list[j].Name
+	})
+	info.Symbols = list
+	return info
+}
+
+// symbolOrder sort untyped const first
+func symbolOrder(s *Symbol) int {
+	if s.Kind == SymbolConst && s.Type == nil {
+		return -1
+	}
+	return int(s.Kind)
+}
+
+// methodSet return the methods of T and the methods only of *T.
+func methodSet(t reflect.Type) (methods []reflect.Method) {
+	skip := make(map[string]bool)
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		skip[m.Name] = true
+		methods = append(methods, m)
+	}
+	ptyp := reflect.PtrTo(t)
+	for i := 0; i < ptyp.NumMethod(); i++ {
+		m := ptyp.Method(i)
+		if skip[m.Name] {
+			continue
+		}
+		methods = append(methods, m)
+	}
+	return
+}
+
+// Lookup lookup symbol by name.
+func (p *PackageInfo) Lookup(name string) (*Symbol, bool) {
+	for _, s := range p.Symbols {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// String return the package summary like go doc.
+func (p *PackageInfo) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "package %v // import %q\n\n", p.Name, p.Path)
+	for _, s := range p.Symbols {
+		buf.WriteString(s.Signature())
+		buf.WriteByte('\n')
+		switch s.Kind {
+		case SymbolType:
+			for _, m := range s.Methods {
+				buf.WriteString("    ")
+				writeMethod(&buf, m)
+				buf.WriteByte('\n')
+			}
+		case SymbolInterface:
+			for _, m := range s.Methods {
+				buf.WriteString("    ")
+				writeFunc(&buf, m.Name, m.Type)
+				buf.WriteByte('\n')
+			}
+		}
+	}
+	return buf.String()
+}
+
+func writeInterface(w io.Writer, name string, typ reflect.Type) {
+	n := typ.NumMethod()
+	if n == 0 {
+		fmt.Fprintf(w, "type %v interface{}", name)
+		return
+	}
+	fmt.Fprintf(w, "type %v interface{\n", name)
+	for i := 0; i < n; i++ {
+		m := typ.Method(i)
+		w.Write([]byte("    "))
+		writeFunc(w, m.Name, m.Type)
+		w.Write([]byte{'\n'})
+	}
+	w.Write([]byte{'}'})
+}
+
+func writeStruct(w io.Writer, name string, typ reflect.Type) {
+	n := typ.NumField()
+	if n == 0 {
+		fmt.Fprintf(w, "type %v struct{}", name)
+		return
+	}
+	fmt.Fprintf(w, "type %v struct{\n", name)
+	var elided bool
+	for i := 0; i < n; i++ {
+		f := typ.Field(i)
+		if f.PkgPath != "" {
+			if !elided {
+				elided = true
+				fmt.Fprintf(w, "    // ... other fields elided ...\n")
+			}
+			continue
+		}
+		if f.Anonymous {
+			fmt.Fprintf(w, "    %v\n", f.Type)
+		} else {
+			fmt.Fprintf(w, "    %v %v\n", f.Name, f.Type)
+		}
+	}
+	fmt.Fprintf(w, "}")
+}
+
+func writeMethod(w io.Writer, m reflect.Method) {
+	typ := m.Type
+	numIn := typ.NumIn()
+	numOut := typ.NumOut()
+	var ins []string
+	if typ.IsVariadic() {
+		for i := 1; i < numIn-1; i++ {
+			ins = append(ins, typ.In(i).String())
+		}
+		ins = append(ins, "..."+typ.In(numIn-1).Elem().String())
+	} else {
+		for i := 1; i < numIn; i++ {
+			ins = append(ins, typ.In(i).String())
+		}
+	}
+	switch numOut {
+	case 0:
+		fmt.Fprintf(w, "func (%v) %v(%v)", typ.In(0), m.Name, strings.Join(ins, ", "))
+	case 1:
+		fmt.Fprintf(w, "func (%v) %v(%v) %v", typ.In(0), m.Name, strings.Join(ins, ", "), typ.Out(0).String())
+	default:
+		var outs []string
+		for i := 0; i < numOut; i++ {
+			outs = append(outs, typ.Out(i).String())
+		}
+		fmt.Fprintf(w, "func (%v) %v(%v) (%v)", typ.In(0), m.Name, strings.Join(ins, ", "), strings.Join(outs, ", "))
+	}
+}
+
+func writeFunc(w io.Writer, name string, typ reflect.Type) {
+	numIn := typ.NumIn()
+	numOut := typ.NumOut()
+	var ins []string
+	if typ.IsVariadic() {
+		for i := 0; i < numIn-1; i++ {
+			ins = append(ins, typ.In(i).String())
+		}
+		ins = append(ins, "..."+typ.In(numIn-1).Elem().String())
+	} else {
+		for i := 0; i < numIn; i++ {
+			ins = append(ins, typ.In(i).String())
+		}
+	}
+	switch numOut {
+	case 0:
+		fmt.Fprintf(w, "func %v(%v)", name, strings.Join(ins, ", "))
+	case 1:
+		fmt.Fprintf(w, "func %v(%v) %v", name, strings.Join(ins, ", "), typ.Out(0).String())
+	default:
+		var outs []string
+		for i := 0; i < numOut; i++ {
+			outs = append(outs, typ.Out(i).String())
+		}
+		fmt.Fprintf(w, "func %v(%v) (%v)", name, strings.Join(ins, ", "), strings.Join(outs, ", "))
+	}
+}
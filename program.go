@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/tools/go/ssa"
+)
+
+// Program is the compiled program of package, the functions and types are
+// compiled once and shared by the interps created by NewInterp. It is
+// immutable and safe for concurrent use.
+type Program struct {
+	interp  *Interp       // interp compiled the program, it is not run
+	slots   []programSlot // registers of globals and functions set by each interp
+	running sync.Map      // goroutine id -> *Interp, find interp of the methods called by native
+}
+
+// programSlot is the register of function set by each interp.
+type programSlot struct {
+	id int       // id of function
+	ir register  // register of stack
+	v  ssa.Value // *ssa.Global or *ssa.Function
+}
+
+// NewProgram compile the main package to the program shared by interps.
+// The modes CheckDataRace, CheckDeadlock and DeterministicSchedule, record,
+// replay and virtual clock bind to one interp and are not supported. The
+// native functions mocked by Context are patched for all interps, the
+// interpreted functions are patched by each interp. The methods called by
+// the goroutine started by native must be reached by a closure of interp,
+// else panic with ErrNoInterp.
+func (ctx *Context) NewProgram(mainpkg *ssa.Package) (*Program, error) {
+	if ctx.Mode&(CheckDataRace|CheckDeadlock|DeterministicSchedule) != 0 || ctx.clock != nil || ctx.record != nil {
+		return nil, ErrProgramMode
+	}
+	p := &Program{}
+	i, err := newInterp(ctx, mainpkg, nil, p)
+	if err != nil {
+		return nil, err
+	}
+	p.interp = i
+	// compile all functions, the functions may load more in compiling
+	for {
+		var pfns []*function
+		for _, pfn := range i.funcs {
+			if atomic.LoadInt32(&pfn.compiled) == 0 {
+				pfns = append(pfns, pfn)
+			}
+		}
+		if len(pfns) == 0 {
+			break
+		}
+		for _, pfn := range pfns {
+			pfn.build()
+		}
+	}
+	for _, pfn := range i.funcs {
+		for v, index := range pfn.index {
+			switch v := v.(type) {
+			case *ssa.Global:
+			case *ssa.Function:
+				if v.Blocks == nil {
+					continue
+				}
+			default:
+				continue
+			}
+			p.slots = append(p.slots, programSlot{pfn.id, register(index & 0xffffff), v})
+		}
+	}
+	return p, nil
+}
+
+// NewInterp create the interp run the program, it has own globals and
+// goroutines. The interp is ready to RunInit and is cheap to create.
+func (p *Program) NewInterp() (*Interp, error) {
+	base := p.interp
+	i := &Interp{
+		ctx:          base.ctx,
+		conf:         base.conf,
+		mainpkg:      base.mainpkg,
+		record:       base.record,
+		globals:      make(map[string]value),
+		chkinit:      make(map[string]bool),
+		goroutines:   1,
+		preloadTypes: base.preloadTypes,
+		funcs:        make(map[*ssa.Function]*function, len(base.funcs)),
+		msets:        base.msets,
+		natives:      base.natives,
+		chexit:       make(chan int),
+		chabort:      make(chan struct{}),
+		mainid:       goroutineID(),
+		program:      p,
+		fns:          make([]*function, len(base.funcs)),
+	}
+	if i.conf.mode&CheckGoroutineLeak != 0 {
+		i.leak = newLeakChecker(i)
+	}
+	i.initGlobals(nil)
+	for fn, pfn := range base.funcs {
+		c := pfn.clone()
+		c.Interp = i
+		c.stack = append([]value{}, pfn.stack...)
+		i.funcs[fn] = c
+		i.fns[pfn.id] = c
+	}
+	for _, s := range p.slots {
+		var v value
+		switch f := s.v.(type) {
+		case *ssa.Global:
+			v, _ = globalToValue(i, f)
+		case *ssa.Function:
+			v = i.funcs[f].makeFunction(base.toType(f.Type()), nil).Interface()
+		}
+		i.fns[s.id].stack[s.ir] = v
+	}
+	for symbol, fn := range i.ctx.mocks {
+		if i.isNativePatch(symbol) {
+			continue // patched by program
+		}
+		if _, err := i.Patch(symbol, fn); err != nil {
+			return nil, err
+		}
+	}
+	return i, nil
+}
+
+// enter record goroutine run by interp, return the goroutine id.
+func (p *Program) enter(i *Interp) int64 {
+	id := goroutineID()
+	p.running.Store(id, i)
+	return id
+}
@@ -0,0 +1,721 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+
+	"golang.org/x/tools/go/ssa"
+)
+
+// raceExitCode is the exit code of program found data race like go.
+const raceExitCode = 66
+
+func registerRaceExternals(ctx *Context) {
+	ctx.RegisterExternal("(*sync.Once).Do", raceOnceDo)
+}
+
+// raceOnceDo release the once after f returns, f happens before Do returns.
+func raceOnceDo(fr *frame, o *sync.Once, f func()) {
+	r := fr.interp.race
+	o.Do(func() {
+		f()
+		r.release(uintptr(unsafe.Pointer(o)))
+	})
+	r.acquire(uintptr(unsafe.Pointer(o)))
+}
+
+// vclock is the vector clock indexed by thread id.
+type vclock []uint64
+
+func (c vclock) get(tid int) uint64 {
+	if tid < len(c) {
+		return c[tid]
+	}
+	return 0
+}
+
+func (c *vclock) join(o vclock) {
+	if len(*c) < len(o) {
+		*c = append(*c, make(vclock, len(o)-len(*c))...)
+	}
+	for i, v := range o {
+		if v > (*c)[i] {
+			(*c)[i] = v
+		}
+	}
+}
+
+type raceThread struct {
+	tid     int
+	gid     int64
+	clock   vclock
+	created []uintptr // pcs of go statement
+	exited  bool
+}
+
+func (t *raceThread) tick() {
+	t.clock[t.tid]++
+}
+
+func (t *raceThread) name() string {
+	if t.tid == 0 {
+		return "main goroutine"
+	}
+	return fmt.Sprintf("goroutine %v", t.gid)
+}
+
+type raceAccess struct {
+	thread *raceThread
+	clock  uint64
+	write  bool
+	pcs    []uintptr
+}
+
+func (a *raceAccess) kind() string {
+	if a.write {
+		return "write"
+	}
+	return "read"
+}
+
+// happensBefore report access a happens before the current of thread t.
+func (a *raceAccess) happensBefore(t *raceThread) bool {
+	return a.thread == t || a.clock <= t.clock.get(a.thread.tid)
+}
+
+type raceShadow struct {
+	write *raceAccess
+	reads []*raceAccess
+}
+
+const (
+	raceShards     = 64
+	racePageShift  = 12
+	raceShardLimit = 1 << 14 // max shadows and syncs of shard, drop old pages if exceeded
+)
+
+// racePage is the shadows and sync clocks of a memory page.
+type racePage struct {
+	shadows map[uintptr]*raceShadow
+	syncs   map[uintptr]vclock
+}
+
+func (p *racePage) size() int {
+	return len(p.shadows) + len(p.syncs)
+}
+
+// raceShard is the shadow table of pages, keyed by uintptr not keep the
+// objects alive. The memory of interp allocation is reset by alloc.
+type raceShard struct {
+	mu    sync.Mutex
+	pages map[uintptr]*racePage
+	n     int
+}
+
+// page return the page of addr, drop other pages if the shard is full.
+func (s *raceShard) page(addr uintptr) *racePage {
+	key := addr >> racePageShift
+	p, ok := s.pages[key]
+	if s.n >= raceShardLimit {
+		for k, v := range s.pages {
+			if k != key {
+				s.n -= v.size()
+				delete(s.pages, k)
+				if s.n < raceShardLimit/2 {
+					break
+				}
+			}
+		}
+	}
+	if !ok {
+		p = &racePage{
+			shadows: make(map[uintptr]*raceShadow),
+			syncs:   make(map[uintptr]vclock),
+		}
+		s.pages[key] = p
+	}
+	return p
+}
+
+// raceDetector is a happens-before data race detector by vector clock.
+// Memory accesses are store, load, map read and update of interp. The
+// channel operations, go statement and calls of package sync and sync/atomic
+// are synchronization points. The memory allocated by native code is not
+// reset, the reused address may report stale accesses.
+type raceDetector struct {
+	interp   *Interp
+	mu       sync.Mutex // threads, reported
+	threads  sync.Map   // goroutine id -> *raceThread
+	ntid     int
+	shards   [raceShards]raceShard
+	reported map[[2]uintptr]bool
+	races    int32
+	output   io.Writer
+}
+
+func newRaceDetector(interp *Interp) *raceDetector {
+	r := &raceDetector{
+		interp:   interp,
+		reported: make(map[[2]uintptr]bool),
+		output:   os.Stderr,
+	}
+	for i := range r.shards {
+		r.shards[i].pages = make(map[uintptr]*racePage)
+	}
+	return r
+}
+
+func (r *raceDetector) shard(addr uintptr) *raceShard {
+	return &r.shards[(addr>>racePageShift)%raceShards]
+}
+
+func (r *raceDetector) newThread(created []uintptr) *raceThread {
+	t := &raceThread{tid: r.ntid, created: created}
+	r.ntid++
+	t.clock = make(vclock, t.tid+1)
+	t.clock[t.tid] = 1
+	return t
+}
+
+// current return the thread of current goroutine, the goroutine not start
+// by go statement has new thread.
+func (r *raceDetector) current() *raceThread {
+	id := goroutineID()
+	if v, ok := r.threads.Load(id); ok {
+		return v.(*raceThread)
+	}
+	r.mu.Lock()
+	t := r.newThread(nil)
+	r.mu.Unlock()
+	t.gid = id
+	r.threads.Store(id, t)
+	return t
+}
+
+// fork return the thread for go statement, the parent happens before child.
+func (r *raceDetector) fork(fr *frame) *raceThread {
+	parent := r.current()
+	pcs := racePCs(fr)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t := r.newThread(pcs)
+	t.clock.join(parent.clock)
+	parent.tick()
+	return t
+}
+
+// start bind the thread to current goroutine.
+func (r *raceDetector) start(t *raceThread) {
+	t.gid = goroutineID()
+	r.threads.Store(t.gid, t)
+}
+
+func (r *raceDetector) exit(t *raceThread) {
+	r.mu.Lock()
+	t.exited = true
+	r.mu.Unlock()
+	r.threads.Delete(t.gid)
+}
+
+func (r *raceDetector) release(key uintptr) {
+	t := r.current()
+	s := r.shard(key)
+	s.mu.Lock()
+	p := s.page(key)
+	c, ok := p.syncs[key]
+	if !ok {
+		s.n++
+	}
+	c.join(t.clock)
+	p.syncs[key] = c
+	t.tick()
+	s.mu.Unlock()
+}
+
+func (r *raceDetector) acquire(key uintptr) {
+	t := r.current()
+	s := r.shard(key)
+	s.mu.Lock()
+	if p, ok := s.pages[key>>racePageShift]; ok {
+		if c, ok := p.syncs[key]; ok {
+			t.clock.join(c)
+		}
+	}
+	s.mu.Unlock()
+}
+
+// alloc reset the shadows and sync clocks of new allocated memory.
+func (r *raceDetector) alloc(addr uintptr, size uintptr) {
+	if size == 0 {
+		size = 1
+	}
+	end := addr + size
+	for key := addr >> racePageShift; key <= (end-1)>>racePageShift; key++ {
+		s := &r.shards[key%raceShards]
+		s.mu.Lock()
+		if p, ok := s.pages[key]; ok {
+			if key<<racePageShift >= addr && (key+1)<<racePageShift <= end {
+				s.n -= p.size()
+				delete(s.pages, key)
+			} else {
+				for k := range p.shadows {
+					if k >= addr && k < end {
+						delete(p.shadows, k)
+						s.n--
+					}
+				}
+				for k := range p.syncs {
+					if k >= addr && k < end {
+						delete(p.syncs, k)
+						s.n--
+					}
+				}
+			}
+		}
+		s.mu.Unlock()
+	}
+}
+
+func (r *raceDetector) access(fr *frame, addr uintptr, write bool) {
+	t := r.current()
+	pcs := racePCs(fr)
+	sh := r.shard(addr)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	p := sh.page(addr)
+	s, ok := p.shadows[addr]
+	if !ok {
+		s = &raceShadow{}
+		p.shadows[addr] = s
+		sh.n++
+	}
+	cur := &raceAccess{thread: t, clock: t.clock[t.tid], write: write, pcs: pcs}
+	if w := s.write; w != nil && !w.happensBefore(t) {
+		r.report(addr, cur, w)
+	}
+	if write {
+		for _, rd := range s.reads {
+			if !rd.happensBefore(t) {
+				r.report(addr, cur, rd)
+			}
+		}
+		s.write = cur
+		s.reads = s.reads[:0]
+		return
+	}
+	for i, rd := range s.reads {
+		if rd.thread == t {
+			s.reads[i] = cur
+			return
+		}
+	}
+	s.reads = append(s.reads, cur)
+}
+
+// report write the data race, it is called with lock of shard.
+func (r *raceDetector) report(addr uintptr, cur *raceAccess, prev *raceAccess) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := [2]uintptr{racePC(cur.pcs), racePC(prev.pcs)}
+	if r.reported[key] {
+		return
+	}
+	r.reported[key] = true
+	atomic.AddInt32(&r.races, 1)
+	var buf bytes.Buffer
+	buf.WriteString("==================\nWARNING: DATA RACE\n")
+	fmt.Fprintf(&buf, "%v at %#012x by %v:\n", upperFirst(cur.kind()), addr, cur.thread.name())
+	r.writeStack(&buf, cur.pcs)
+	fmt.Fprintf(&buf, "\nPrevious %v at %#012x by %v:\n", prev.kind(), addr, prev.thread.name())
+	r.writeStack(&buf, prev.pcs)
+	for _, t := range []*raceThread{cur.thread, prev.thread} {
+		if t.created == nil {
+			continue
+		}
+		state := "running"
+		if t.exited {
+			state = "finished"
+		}
+		fmt.Fprintf(&buf, "\nGoroutine %v (%v) created at:\n", t.gid, state)
+		r.writeStack(&buf, t.created)
+	}
+	buf.WriteString("==================\n")
+	r.output.Write(buf.Bytes())
+}
+
+// writeStack write pcs like go race detector.
+func (r *raceDetector) writeStack(w *bytes.Buffer, pcs []uintptr) {
+	if len(pcs) == 0 {
+		return
+	}
+	fr := &frame{interp: r.interp}
+	fs := runtime.CallersFrames(pcs)
+	for {
+		f, more := runtimeFramesNext(fr, fs)
+		fmt.Fprintf(w, "  %v()\n      %v:%v", f.Function, f.File, f.Line)
+		if f.PC != f.Entry {
+			fmt.Fprintf(w, " +0x%x", f.PC-f.Entry)
+		}
+		w.WriteByte('\n')
+		if !more {
+			break
+		}
+	}
+}
+
+// found return number of data race reported.
+func (r *raceDetector) found() int {
+	return int(atomic.LoadInt32(&r.races))
+}
+
+func racePCs(fr *frame) (pcs []uintptr) {
+	for ; fr.valid(); fr = fr.caller {
+		pcs = append(pcs, fr.pc())
+	}
+	return
+}
+
+func racePC(pcs []uintptr) uintptr {
+	if len(pcs) == 0 {
+		return 0
+	}
+	return pcs[0]
+}
+
+func upperFirst(s string) string {
+	return string(s[0]-'a'+'A') + s[1:]
+}
+
+func raceAddr(v value) uintptr {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Chan, reflect.UnsafePointer:
+		return rv.Pointer()
+	}
+	return 0
+}
+
+// raceAlloc return the memory range of new allocated value.
+func raceAlloc(v value) (addr uintptr, size uintptr) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		return rv.Pointer(), rv.Type().Elem().Size()
+	case reflect.Slice:
+		return rv.Pointer(), uintptr(rv.Cap()) * rv.Type().Elem().Size()
+	case reflect.Map, reflect.Chan:
+		return rv.Pointer(), 1
+	}
+	return 0, 0
+}
+
+// isRaceSyncFunc check fn is function or method of package sync and sync/atomic.
+func isRaceSyncFunc(fn *ssa.Function) bool {
+	if fn.Blocks != nil || fn.Pkg == nil {
+		return false
+	}
+	switch fn.Pkg.Pkg.Path() {
+	case "sync", "sync/atomic":
+		return true
+	}
+	return false
+}
+
+// instr wrap ifn to record the memory access and synchronization of instr.
+func (r *raceDetector) instr(pfn *function, instr ssa.Instruction, ifn func(fr *frame)) func(fr *frame) {
+	switch instr := instr.(type) {
+	case *ssa.Alloc, *ssa.MakeSlice, *ssa.MakeMap, *ssa.MakeChan:
+		return r.allocOp(pfn.regIndex(instr.(ssa.Value)), ifn)
+	case *ssa.Convert:
+		if _, ok := instr.Type().Underlying().(*types.Slice); ok {
+			return r.allocOp(pfn.regIndex(instr), ifn)
+		}
+	case *ssa.Store:
+		ia := pfn.regIndex(instr.Addr)
+		return func(fr *frame) {
+			if addr := raceAddr(fr.reg(ia)); addr != 0 {
+				r.access(fr, addr, true)
+			}
+			ifn(fr)
+		}
+	case *ssa.UnOp:
+		ix := pfn.regIndex(instr.X)
+		switch instr.Op {
+		case token.MUL:
+			return func(fr *frame) {
+				if addr := raceAddr(fr.reg(ix)); addr != 0 {
+					r.access(fr, addr, false)
+				}
+				ifn(fr)
+			}
+		case token.ARROW:
+			return r.chanOp(ix, ifn)
+		}
+	case *ssa.Send:
+		return r.chanOp(pfn.regIndex(instr.Chan), ifn)
+	case *ssa.Select:
+		ir := pfn.regIndex(instr)
+		ic := make([]register, len(instr.States))
+		for i, state := range instr.States {
+			ic[i] = pfn.regIndex(state.Chan)
+		}
+		return func(fr *frame) {
+			for _, i := range ic {
+				if addr := raceAddr(fr.reg(i)); addr != 0 {
+					r.release(addr)
+				}
+			}
+			ifn(fr)
+			if t, ok := fr.reg(ir).(tuple); ok {
+				if chosen := t[0].(int); chosen >= 0 {
+					if addr := raceAddr(fr.reg(ic[chosen])); addr != 0 {
+						r.acquire(addr)
+					}
+				}
+			}
+		}
+	case *ssa.MapUpdate:
+		return r.mapOp(pfn.regIndex(instr.Map), true, ifn)
+	case *ssa.Lookup:
+		if _, ok := instr.X.Type().Underlying().(*types.Map); ok {
+			return r.mapOp(pfn.regIndex(instr.X), false, ifn)
+		}
+	case *ssa.Range:
+		if _, ok := instr.X.Type().Underlying().(*types.Map); ok {
+			return r.mapOp(pfn.regIndex(instr.X), false, ifn)
+		}
+	case *ssa.Call:
+		switch fn := instr.Call.Value.(type) {
+		case *ssa.Builtin:
+			switch fn.Name() {
+			case "delete":
+				return r.mapOp(pfn.regIndex(instr.Call.Args[0]), true, ifn)
+			case "append":
+				ia := pfn.regIndex(instr.Call.Args[0])
+				ir := pfn.regIndex(instr)
+				return func(fr *frame) {
+					old := reflect.ValueOf(fr.reg(ia)).Pointer()
+					ifn(fr)
+					if addr, size := raceAlloc(fr.reg(ir)); addr != 0 && addr != old {
+						r.alloc(addr, size)
+					}
+				}
+			case "close":
+				ic := pfn.regIndex(instr.Call.Args[0])
+				return func(fr *frame) {
+					if addr := raceAddr(fr.reg(ic)); addr != 0 {
+						r.release(addr)
+					}
+					ifn(fr)
+				}
+			}
+		}
+	}
+	return ifn
+}
+
+// allocOp reset the shadows of memory allocated by instr.
+func (r *raceDetector) allocOp(ir register, ifn func(fr *frame)) func(fr *frame) {
+	return func(fr *frame) {
+		ifn(fr)
+		if addr, size := raceAlloc(fr.reg(ir)); addr != 0 {
+			r.alloc(addr, size)
+		}
+	}
+}
+
+// chanOp release the channel before send or receive, acquire after.
+func (r *raceDetector) chanOp(ic register, ifn func(fr *frame)) func(fr *frame) {
+	return func(fr *frame) {
+		addr := raceAddr(fr.reg(ic))
+		if addr == 0 {
+			ifn(fr)
+			return
+		}
+		r.release(addr)
+		ifn(fr)
+		r.acquire(addr)
+	}
+}
+
+func (r *raceDetector) mapOp(im register, write bool, ifn func(fr *frame)) func(fr *frame) {
+	return func(fr *frame) {
+		if addr := raceAddr(fr.reg(im)); addr != 0 {
+			r.access(fr, addr, write)
+		}
+		ifn(fr)
+	}
+}
+
+// raceSyncOp is the synchronization of sync or sync/atomic call, before
+// is called before the call with the first argument address, after is
+// called after the call with results.
+type raceSyncOp struct {
+	before func(r *raceDetector, addr uintptr, args []reflect.Value)
+	after  func(r *raceDetector, addr uintptr, results []reflect.Value)
+}
+
+func raceReleaseOp(r *raceDetector, addr uintptr, args []reflect.Value) {
+	r.release(addr)
+}
+
+func raceAcquireOp(r *raceDetector, addr uintptr, results []reflect.Value) {
+	r.acquire(addr)
+}
+
+// raceTryAcquireOp acquire if the try lock returns true.
+func raceTryAcquireOp(r *raceDetector, addr uintptr, results []reflect.Value) {
+	if results[0].Bool() {
+		r.acquire(addr)
+	}
+}
+
+// raceRWLockOp acquire the Unlock and RUnlock of RWMutex. The RUnlock
+// release addr+1, RLock not synchronize with other RLock.
+func raceRWLockOp(r *raceDetector, addr uintptr, results []reflect.Value) {
+	if len(results) == 0 || results[0].Bool() {
+		r.acquire(addr)
+		r.acquire(addr + 1)
+	}
+}
+
+func raceRUnlockOp(r *raceDetector, addr uintptr, args []reflect.Value) {
+	r.release(addr + 1)
+}
+
+// raceWaitGroupAddOp release if delta is negative like Done.
+func raceWaitGroupAddOp(r *raceDetector, addr uintptr, args []reflect.Value) {
+	if args[len(args)-1].Int() < 0 {
+		r.release(addr)
+	}
+}
+
+// raceCondWaitOp release the Locker of Cond before Wait, Wait unlock it.
+func raceCondWaitOp(r *raceDetector, addr uintptr, args []reflect.Value) {
+	if c, ok := args[len(args)-1].Interface().(*sync.Cond); ok && c.L != nil {
+		if locker := raceAddr(c.L); locker != 0 {
+			r.release(locker)
+		}
+	}
+}
+
+var raceSyncOps = map[string]raceSyncOp{
+	"(*sync.Mutex).Lock":           {after: raceAcquireOp},
+	"(*sync.Mutex).TryLock":        {after: raceTryAcquireOp},
+	"(*sync.Mutex).Unlock":         {before: raceReleaseOp},
+	"(*sync.RWMutex).Lock":         {after: raceRWLockOp},
+	"(*sync.RWMutex).TryLock":      {after: raceRWLockOp},
+	"(*sync.RWMutex).Unlock":       {before: raceReleaseOp},
+	"(*sync.RWMutex).RLock":        {after: raceAcquireOp},
+	"(*sync.RWMutex).TryRLock":     {after: raceTryAcquireOp},
+	"(*sync.RWMutex).RUnlock":      {before: raceRUnlockOp},
+	"(*sync.WaitGroup).Add":        {before: raceWaitGroupAddOp},
+	"(*sync.WaitGroup).Done":       {before: raceReleaseOp},
+	"(*sync.WaitGroup).Wait":       {after: raceAcquireOp},
+	"(*sync.Map).Load":             {after: raceAcquireOp},
+	"(*sync.Map).Range":            {after: raceAcquireOp},
+	"(*sync.Map).Store":            {before: raceReleaseOp},
+	"(*sync.Map).Delete":           {before: raceReleaseOp},
+	"(*sync.Map).Clear":            {before: raceReleaseOp},
+	"(*sync.Map).LoadOrStore":      {raceReleaseOp, raceAcquireOp},
+	"(*sync.Map).LoadAndDelete":    {raceReleaseOp, raceAcquireOp},
+	"(*sync.Map).Swap":             {raceReleaseOp, raceAcquireOp},
+	"(*sync.Map).CompareAndSwap":   {raceReleaseOp, raceAcquireOp},
+	"(*sync.Map).CompareAndDelete": {raceReleaseOp, raceAcquireOp},
+}
+
+// lookupRaceSyncOp return the synchronization of fn. The Cond.Wait also
+// release and acquire the Locker. The Load of sync/atomic is acquire, Store
+// is release, and read-modify-write is both.
+func lookupRaceSyncOp(fn *ssa.Function) (op raceSyncOp, ok bool) {
+	if !isRaceSyncFunc(fn) {
+		return
+	}
+	if fn.Pkg.Pkg.Path() == "sync" {
+		if fn.String() == "(*sync.Cond).Wait" {
+			return raceSyncOp{before: raceCondWaitOp}, true
+		}
+		op, ok = raceSyncOps[fn.String()]
+		return
+	}
+	name := fn.Name()
+	switch {
+	case strings.HasPrefix(name, "Load"):
+		return raceSyncOp{after: raceAcquireOp}, true
+	case strings.HasPrefix(name, "Store"):
+		return raceSyncOp{before: raceReleaseOp}, true
+	case strings.HasPrefix(name, "Add"), strings.HasPrefix(name, "Swap"),
+		strings.HasPrefix(name, "CompareAndSwap"),
+		strings.HasPrefix(name, "And"), strings.HasPrefix(name, "Or"):
+		return raceSyncOp{raceReleaseOp, raceAcquireOp}, true
+	}
+	return
+}
+
+// syncFunc wrap the external function of sync and sync/atomic by the
+// synchronization of lookupRaceSyncOp.
+func (r *raceDetector) syncFunc(fn *ssa.Function, ext reflect.Value) reflect.Value {
+	op, ok := lookupRaceSyncOp(fn)
+	if !ok {
+		return ext
+	}
+	typ := ext.Type()
+	n := 0
+	if typ.NumIn() > 0 && typ.In(0) == typFramePtr {
+		n = 1
+	}
+	if typ.NumIn() <= n {
+		return ext
+	}
+	isCondWait := fn.String() == "(*sync.Cond).Wait"
+	return reflect.MakeFunc(typ, func(args []reflect.Value) []reflect.Value {
+		x := args[n].Interface()
+		addr := raceAddr(x)
+		if addr == 0 {
+			return ext.Call(args)
+		}
+		var locker uintptr
+		if isCondWait {
+			if c, ok := x.(*sync.Cond); ok && c.L != nil {
+				locker = raceAddr(c.L)
+			}
+		}
+		if op.before != nil {
+			op.before(r, addr, args[n:])
+		}
+		results := ext.Call(args)
+		if op.after != nil {
+			op.after(r, addr, results)
+		}
+		if locker != 0 {
+			r.acquire(locker)
+		}
+		return results
+	})
+}
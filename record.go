@@ -0,0 +1,383 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sync"
+	"syscall"
+)
+
+// recordFuncs is the external functions return nondeterministic values,
+// the results and filled []byte arguments are recorded. The functions are
+// intercepted at static calls and interface method calls of interpreted
+// code, the calls inside native packages like bufio and net/http are not
+// recorded. The network connections and files are still opened on replay,
+// only the data read is replayed.
+var recordFuncs = map[string]bool{
+	"time.Now":                   true,
+	"time.Since":                 true,
+	"time.Until":                 true,
+	"math/rand.Int":              true,
+	"math/rand.Intn":             true,
+	"math/rand.Int31":            true,
+	"math/rand.Int31n":           true,
+	"math/rand.Int63":            true,
+	"math/rand.Int63n":           true,
+	"math/rand.Uint32":           true,
+	"math/rand.Uint64":           true,
+	"math/rand.Float32":          true,
+	"math/rand.Float64":          true,
+	"math/rand.ExpFloat64":       true,
+	"math/rand.NormFloat64":      true,
+	"math/rand.Perm":             true,
+	"math/rand.Read":             true,
+	"crypto/rand.Read":           true,
+	"crypto/rand.Int":            true,
+	"crypto/rand.Prime":          true,
+	"os.Getenv":                  true,
+	"os.LookupEnv":               true,
+	"os.Environ":                 true,
+	"os.ExpandEnv":               true,
+	"os.Hostname":                true,
+	"os.Getpid":                  true,
+	"os.Getppid":                 true,
+	"os.Getuid":                  true,
+	"os.Getwd":                   true,
+	"os.Executable":              true,
+	"os.UserHomeDir":             true,
+	"os.ReadFile":                true,
+	"io/ioutil.ReadFile":         true,
+	"(*os.File).Read":            true,
+	"(*os.File).ReadAt":          true,
+	"net.LookupHost":             true,
+	"net.LookupIP":               true,
+	"net.LookupAddr":             true,
+	"(*net.TCPConn).Read":        true,
+	"(*net.UDPConn).Read":        true,
+	"(*net.UDPConn).ReadFrom":    true,
+	"(*net.UnixConn).Read":       true,
+	"(*net.IPConn).Read":         true,
+	"(*net.UDPConn).ReadFromUDP": true,
+}
+
+// recordErrors is the sentinel errors keep identity on replay.
+var recordErrors = []struct {
+	name string
+	err  error
+}{
+	{"io.EOF", io.EOF},
+	{"io.ErrUnexpectedEOF", io.ErrUnexpectedEOF},
+	{"os.ErrNotExist", os.ErrNotExist},
+	{"os.ErrExist", os.ErrExist},
+	{"os.ErrPermission", os.ErrPermission},
+	{"os.ErrDeadlineExceeded", os.ErrDeadlineExceeded},
+}
+
+var typError = reflect.TypeOf((*error)(nil)).Elem()
+
+// recordHeader is the first entry of trace.
+type recordHeader struct {
+	Seed    int64 // seed of deterministic schedule
+	Quantum int   // quantum of deterministic schedule
+}
+
+// recordValue is the result of external call.
+type recordValue struct {
+	Err  *recordError // error result, nil if no error
+	Data []byte       // gob encoded value
+}
+
+// recordError is the error result, the errors of os and syscall keep the
+// structure for os.IsNotExist and others.
+type recordError struct {
+	Kind  int    // recordPathError, recordSyscallError, recordErrno or 0
+	Msg   string // error message
+	Name  string // name of recordErrors is or match errors.Is
+	Op    string // Op of os.PathError or Syscall of os.SyscallError
+	Path  string // Path of os.PathError
+	Errno uintptr
+	Err   *recordError // wrapped error
+}
+
+const (
+	recordPathError = iota + 1
+	recordSyscallError
+	recordErrno
+)
+
+// recordCall is the entry of external call.
+type recordCall struct {
+	Func    string
+	Results []recordValue
+	Out     [][]byte // content of []byte arguments after call
+}
+
+// replayError is the error replayed by message, it unwrap to the sentinel
+// error matched when recorded.
+type replayError struct {
+	msg string
+	err error
+}
+
+func (e *replayError) Error() string {
+	return e.msg
+}
+
+func (e *replayError) Unwrap() error {
+	return e.err
+}
+
+// recorder record the nondeterministic external calls to trace, or replay
+// them from trace.
+type recorder struct {
+	mu      sync.Mutex
+	header  recordHeader
+	enc     *gob.Encoder // record mode
+	dec     *gob.Decoder // replay mode
+	seq     uint64       // sequence of next call
+	next    uint64       // sequence of next entry to write or read
+	pending map[uint64]*recordCall
+	methods sync.Map // method name -> wrapped func
+}
+
+// SetRecord record the nondeterministic values of program observed by
+// external calls to w, the goroutines are scheduled by DeterministicSchedule
+// mode for replay.
+func (ctx *Context) SetRecord(w io.Writer) {
+	ctx.enableSchedule()
+	ctx.record = &recorder{enc: gob.NewEncoder(w), pending: make(map[uint64]*recordCall)}
+}
+
+// SetReplay replay the trace written by SetRecord, the external calls
+// return the recorded values instead of call.
+func (ctx *Context) SetReplay(r io.Reader) error {
+	rec := &recorder{dec: gob.NewDecoder(r), pending: make(map[uint64]*recordCall)}
+	if err := rec.dec.Decode(&rec.header); err != nil {
+		return fmt.Errorf("read replay trace: %w", err)
+	}
+	ctx.enableSchedule()
+	ctx.schedSeed = rec.header.Seed
+	ctx.schedQuantum = rec.header.Quantum
+	ctx.record = rec
+	return nil
+}
+
+func (ctx *Context) enableSchedule() {
+	if ctx.Mode&DeterministicSchedule == 0 {
+		ctx.Mode |= DeterministicSchedule
+		registerScheduleExternals(ctx)
+	}
+}
+
+// start write the header of trace in record mode.
+func (r *recorder) start(ctx *Context) error {
+	if r.enc == nil {
+		return nil
+	}
+	r.header = recordHeader{Seed: ctx.schedSeed, Quantum: ctx.schedQuantum}
+	if err := r.enc.Encode(&r.header); err != nil {
+		return fmt.Errorf("write record trace: %w", err)
+	}
+	return nil
+}
+
+// wrap the external function to record or replay the call. The sequence of
+// call is taken at call, the lock is only held to write or read the entry,
+// so the blocking calls run concurrently.
+func (r *recorder) wrap(name string, ext reflect.Value) reflect.Value {
+	typ := ext.Type()
+	return reflect.MakeFunc(typ, func(args []reflect.Value) []reflect.Value {
+		r.mu.Lock()
+		seq := r.seq
+		r.seq++
+		r.mu.Unlock()
+		if r.dec != nil {
+			return r.replay(seq, name, typ, args)
+		}
+		var results []reflect.Value
+		if typ.IsVariadic() {
+			results = ext.CallSlice(args)
+		} else {
+			results = ext.Call(args)
+		}
+		r.record(seq, name, args, results)
+		return results
+	})
+}
+
+// method return the wrapped method of native type if recorded.
+func (r *recorder) method(typ reflect.Type, name string, ext reflect.Value) reflect.Value {
+	fnName := "(" + typ.String() + ")." + name
+	if !recordFuncs[fnName] {
+		return ext
+	}
+	if v, ok := r.methods.Load(fnName); ok {
+		return v.(reflect.Value)
+	}
+	v, _ := r.methods.LoadOrStore(fnName, r.wrap(fnName, ext))
+	return v.(reflect.Value)
+}
+
+// record write the entries in sequence, the entry wait for the calls
+// before it return.
+func (r *recorder) record(seq uint64, name string, args []reflect.Value, results []reflect.Value) {
+	call := &recordCall{Func: name}
+	for _, arg := range args {
+		if isByteSlice(arg.Type()) {
+			call.Out = append(call.Out, append([]byte(nil), arg.Bytes()...))
+		}
+	}
+	for _, v := range results {
+		var rv recordValue
+		if v.Type() == typError {
+			if !v.IsNil() {
+				rv.Err = newRecordError(v.Interface().(error))
+			}
+		} else {
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).EncodeValue(v); err != nil {
+				panic(plainError(fmt.Sprintf("record %v: %v", name, err)))
+			}
+			rv.Data = buf.Bytes()
+		}
+		call.Results = append(call.Results, rv)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending[seq] = call
+	for {
+		call, ok := r.pending[r.next]
+		if !ok {
+			break
+		}
+		delete(r.pending, r.next)
+		r.next++
+		if err := r.enc.Encode(call); err != nil {
+			panic(plainError(fmt.Sprintf("write record trace: %v", err)))
+		}
+	}
+}
+
+// entry read the entry of call seq, the entries before it are kept for
+// the calls not read yet.
+func (r *recorder) entry(seq uint64) (*recordCall, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for {
+		if call, ok := r.pending[seq]; ok {
+			delete(r.pending, seq)
+			return call, nil
+		}
+		call := &recordCall{}
+		if err := r.dec.Decode(call); err != nil {
+			return nil, err
+		}
+		r.pending[r.next] = call
+		r.next++
+	}
+}
+
+func (r *recorder) replay(seq uint64, name string, typ reflect.Type, args []reflect.Value) []reflect.Value {
+	call, err := r.entry(seq)
+	if err != nil {
+		if err == io.EOF {
+			panic(fmt.Errorf("%w: call %v after the end of trace", ErrReplayDiverged, name))
+		}
+		panic(fmt.Errorf("read replay trace: %w", err))
+	}
+	if call.Func != name || len(call.Results) != typ.NumOut() {
+		panic(fmt.Errorf("%w: call %v, trace has %v", ErrReplayDiverged, name, call.Func))
+	}
+	var n int
+	for _, arg := range args {
+		if isByteSlice(arg.Type()) && n < len(call.Out) {
+			reflect.Copy(arg, reflect.ValueOf(call.Out[n]))
+			n++
+		}
+	}
+	results := make([]reflect.Value, len(call.Results))
+	for i, rv := range call.Results {
+		t := typ.Out(i)
+		v := reflect.New(t).Elem()
+		if t == typError {
+			if rv.Err != nil {
+				v.Set(reflect.ValueOf(rv.Err.error()))
+			}
+		} else if err := gob.NewDecoder(bytes.NewReader(rv.Data)).DecodeValue(v.Addr()); err != nil {
+			panic(fmt.Errorf("read replay trace: %w", err))
+		}
+		results[i] = v
+	}
+	return results
+}
+
+func newRecordError(err error) *recordError {
+	if err == nil {
+		return nil
+	}
+	switch e := err.(type) {
+	case *os.PathError:
+		return &recordError{Kind: recordPathError, Op: e.Op, Path: e.Path, Err: newRecordError(e.Err)}
+	case *os.SyscallError:
+		return &recordError{Kind: recordSyscallError, Op: e.Syscall, Err: newRecordError(e.Err)}
+	case syscall.Errno:
+		return &recordError{Kind: recordErrno, Errno: uintptr(e)}
+	}
+	re := &recordError{Msg: err.Error()}
+	for _, e := range recordErrors {
+		if errors.Is(err, e.err) {
+			re.Name = e.name
+			break
+		}
+	}
+	return re
+}
+
+// error return the error replayed.
+func (re *recordError) error() error {
+	if re == nil {
+		return nil
+	}
+	switch re.Kind {
+	case recordPathError:
+		return &os.PathError{Op: re.Op, Path: re.Path, Err: re.Err.error()}
+	case recordSyscallError:
+		return &os.SyscallError{Syscall: re.Op, Err: re.Err.error()}
+	case recordErrno:
+		return syscall.Errno(re.Errno)
+	}
+	for _, e := range recordErrors {
+		if e.name == re.Name {
+			if e.err.Error() == re.Msg {
+				return e.err
+			}
+			return &replayError{msg: re.Msg, err: e.err}
+		}
+	}
+	return &replayError{msg: re.Msg}
+}
+
+func isByteSlice(typ reflect.Type) bool {
+	return typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Uint8
+}
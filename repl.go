@@ -261,7 +261,7 @@ func (r *Repl) eval(tok token.Token, expr string) (err error) {
 	if err != nil {
 		return err
 	}
-	i, err := newInterp(r.ctx, r.pkg, r.globalMap)
+	i, err := newInterp(r.ctx, r.pkg, r.globalMap, nil)
 	if err != nil {
 		return err
 	}
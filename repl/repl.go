@@ -141,24 +141,20 @@ func (r *REPL) Dump(expr string) {
 }
 
 func (r *REPL) tryDumpByPkg(expr string) error {
-	pkg, sym, _, err := parseSymbol(expr)
-	if err != nil {
-		return err
-	}
-	if pkgPath, found := findPkg(pkg); found {
-		if p, found := igop.LookupPackage(pkgPath); found {
+	if pkgPath, sym, _, found := igop.LookupSymbol(expr); found {
+		if p, found := igop.LookupPackageInfo(pkgPath); found {
 			if sym == "" {
-				r.Printf("%v\n", dumpPkg(p))
+				r.Printf("%v\n", p)
 				return nil
 			}
-			if info, ok := lookupSymbol(p, sym); ok {
-				r.Printf("%v\n", info)
+			if s, ok := p.Lookup(sym); ok {
+				r.Printf("%v\n", s)
 				return nil
 			}
-			return fmt.Errorf("not found symbol %v.%v", pkg, sym)
+			return fmt.Errorf("not found symbol %v.%v", p.Name, sym)
 		}
 	}
-	return fmt.Errorf("not found pkg %v", pkg)
+	return fmt.Errorf("not found pkg %v", expr)
 }
 
 func (r *REPL) godoc(expr string) error {
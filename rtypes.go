@@ -147,17 +147,23 @@ func (r *TypesLoader) LookupTypes(typ reflect.Type) (types.Type, bool) {
 
 func (r *TypesLoader) Import(path string) (*types.Package, error) {
 	if p, ok := r.packages[path]; ok {
-		if !p.Complete() {
-			if load, ok := r.pkgloads[path]; ok {
-				load()
-			}
-			if pkg, ok := registerPkgs[path]; ok {
-				r.installed[path] = pkg
-			}
+		if p.Complete() {
+			return p, nil
+		}
+		if load, ok := r.pkgloads[path]; ok {
+			load()
+		}
+		pkg, ok := LookupPackage(path)
+		if !ok {
+			return p, nil
+		}
+		// placeholder created by types of other package, load the source package
+		if len(pkg.Source) == 0 || r.installed[path] != nil {
+			r.installed[path] = pkg
+			return p, nil
 		}
-		return p, nil
 	}
-	pkg, ok := registerPkgs[path]
+	pkg, ok := LookupPackage(path)
 	if !ok {
 		return nil, fmt.Errorf("not found package %v", path)
 	}
@@ -194,9 +200,36 @@ func (r *TypesLoader) Import(path string) (*types.Package, error) {
 	}
 	p.SetImports(list)
 	p.MarkComplete()
+	if len(pkg.GenericSource) > 0 {
+		if err := r.installGenerics(p, pkg); err != nil {
+			return nil, err
+		}
+	}
 	return p, nil
 }
 
+// installGenerics check the generic declarations of register package as
+// package Path@generic, it import the register package. The exported
+// objects insert to the scope of register package, the SSA package is
+// created after the register package.
+func (r *TypesLoader) installGenerics(p *types.Package, pkg *Package) error {
+	tp, err := r.ctx.addImportFile(pkg.Path+"@generic", pkg.Name+"_generic.go", pkg.GenericSource)
+	if err != nil {
+		return err
+	}
+	if err := tp.Load(); err != nil {
+		return err
+	}
+	tp.Register = true
+	scope := tp.Package.Scope()
+	for _, name := range scope.Names() {
+		if token.IsExported(name) {
+			p.Scope().Insert(scope.Lookup(name))
+		}
+	}
+	return nil
+}
+
 func (r *TypesLoader) installPackage(pkg *Package) (err error) {
 	defer func() {
 		if e := recover(); e != nil {
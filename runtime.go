@@ -27,7 +27,9 @@ import (
 	"regexp"
 	"runtime"
 	"strings"
+	"sync"
 	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"github.com/visualfc/funcval"
@@ -62,6 +64,10 @@ func init() {
 	RegisterExternal("runtime.Stack", runtimeStack)
 	RegisterExternal("runtime/debug.Stack", debugStack)
 	RegisterExternal("runtime/debug.PrintStack", debugPrintStack)
+	RegisterExternal("time.Sleep", func(fr *frame, d time.Duration) {
+		fr.interp.sleep(fr, d)
+	})
+	RegisterExternal("(*sync.WaitGroup).Wait", syncWaitGroupWait)
 
 	if funcval.IsSupport {
 		RegisterExternal("(reflect.Value).Pointer", func(v reflect.Value) uintptr {
@@ -76,6 +82,40 @@ func init() {
 	}
 }
 
+// syncWaitGroupWait wait the WaitGroup. The wait is wake up by Interp.Abort
+// if run with RunContext or CheckDeadlock mode, the goroutine of wait exits
+// when the WaitGroup counter is zero.
+func syncWaitGroupWait(fr *frame, wg *sync.WaitGroup) {
+	interp := fr.interp
+	if d := interp.deadlock; d != nil {
+		defer d.unblock(d.block(fr, waitReasonWaitGroup, true))
+	}
+	if l := interp.leak; l != nil {
+		defer l.unblock(l.block(fr, waitReasonWaitGroup))
+	}
+	if c := interp.clock; c != nil {
+		defer c.unblock(c.block())
+	}
+	if interp.ctx.RunContext == nil && interp.deadlock == nil {
+		wg.Wait()
+		return
+	}
+	select {
+	case <-waitGroupDone(wg):
+	case <-interp.chabort:
+	}
+}
+
+// waitGroupDone return the channel closed when the WaitGroup counter is zero.
+func waitGroupDone(wg *sync.WaitGroup) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	return done
+}
+
 func runtimeFuncFileLine(fr *frame, f *runtime.Func, pc uintptr) (file string, line int) {
 	entry := f.Entry()
 	if isInlineFunc(f) && pc > entry {
@@ -205,22 +245,6 @@ func runtimeFunc(pfn *function) *runtime.Func {
 	return (*runtime.Func)(unsafe.Pointer(f))
 }
 
-/*
-	type Frames struct {
-		// callers is a slice of PCs that have not yet been expanded to frames.
-		callers []uintptr
-
-		// frames is a slice of Frames that have yet to be returned.
-		frames     []Frame
-		frameStore [2]Frame
-	}
-*/
-type runtimeFrames struct {
-	callers    []uintptr
-	frames     []runtime.Frame
-	frameStore [2]runtime.Frame
-}
-
 func runtimeFramesNext(fr *frame, frames *runtime.Frames) (frame runtime.Frame, more bool) {
 	ci := (*runtimeFrames)(unsafe.Pointer(frames))
 	for len(ci.frames) < 2 {
@@ -290,6 +314,12 @@ func runtimeStack(fr *frame, buf []byte, all bool) int {
 	} else {
 		w.WriteString("goroutine 1 [running]:\n")
 	}
+	writeStack(&w, fr)
+	return copy(buf, w.Bytes())
+}
+
+// writeStack write the stack of fr and callers like runtime.Stack.
+func writeStack(w *bytes.Buffer, fr *frame) {
 	rpc := make([]uintptr, 64)
 	n := runtimeCallers(fr, 1, rpc)
 	fs := runtime.CallersFrames(rpc[:n])
@@ -311,7 +341,6 @@ func runtimeStack(fr *frame, buf []byte, all bool) int {
 			break
 		}
 	}
-	return copy(buf, w.Bytes())
 }
 
 // PrintStack prints to standard error the stack trace returned by runtime.Stack.
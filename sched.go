@@ -0,0 +1,666 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+	"unsafe"
+)
+
+// defaultScheduleQuantum is the number of instructions run before preempt.
+const defaultScheduleQuantum = 1000
+
+// schedulePoll is the interval of poll when all goroutines are blocked,
+// wait for native wake up like real timers, I/O and native goroutines.
+var schedulePoll = time.Millisecond
+
+func registerScheduleExternals(ctx *Context) {
+	ctx.RegisterExternal("runtime.Gosched", schedGosched)
+	ctx.RegisterExternal("(*sync.Mutex).Lock", schedMutexLock)
+	ctx.RegisterExternal("(*sync.Mutex).Unlock", schedMutexUnlock)
+	ctx.RegisterExternal("(*sync.RWMutex).Lock", schedRWMutexLock)
+	ctx.RegisterExternal("(*sync.RWMutex).Unlock", schedRWMutexUnlock)
+	ctx.RegisterExternal("(*sync.RWMutex).RLock", schedRWMutexRLock)
+	ctx.RegisterExternal("(*sync.RWMutex).RUnlock", schedRWMutexRUnlock)
+	ctx.RegisterExternal("(*sync.WaitGroup).Wait", schedWaitGroupWait)
+	ctx.RegisterExternal("(*sync.Once).Do", schedOnceDo)
+	ctx.RegisterExternal("(*sync.Cond).Wait", schedCondWait)
+	ctx.RegisterExternal("(*sync.Cond).Signal", schedCondSignal)
+	ctx.RegisterExternal("(*sync.Cond).Broadcast", schedCondBroadcast)
+}
+
+func schedGosched(fr *frame) {
+	s := fr.interp.sched
+	if g := s.current(); g != nil {
+		s.yield(g)
+	}
+}
+
+// schedUnlock unlock and yield to other goroutines.
+func schedUnlock(fr *frame, unlock func()) {
+	unlock()
+	s := fr.interp.sched
+	if g := s.current(); g != nil {
+		s.yield(g)
+	}
+}
+
+func schedMutexUnlock(fr *frame, m *sync.Mutex) {
+	schedUnlock(fr, m.Unlock)
+}
+
+func schedRWMutexUnlock(fr *frame, m *sync.RWMutex) {
+	schedUnlock(fr, m.Unlock)
+}
+
+func schedRWMutexRUnlock(fr *frame, m *sync.RWMutex) {
+	schedUnlock(fr, m.RUnlock)
+}
+
+// schedWaitGroupWait poll the WaitGroup done by the scheduler.
+func schedWaitGroupWait(fr *frame, wg *sync.WaitGroup) {
+	s := fr.interp.sched
+	g := s.current()
+	if g == nil {
+		syncWaitGroupWait(fr, wg)
+		return
+	}
+	if d := fr.interp.deadlock; d != nil {
+		defer d.unblock(d.block(fr, waitReasonWaitGroup, true))
+	}
+	if l := fr.interp.leak; l != nil {
+		defer l.unblock(l.block(fr, waitReasonWaitGroup))
+	}
+	if c := fr.interp.clock; c != nil {
+		defer c.unblock(c.block())
+	}
+	done := waitGroupDone(wg)
+	s.yield(g)
+	s.wait(g, func() bool {
+		select {
+		case <-done:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+// schedOnceDo run f by the scheduler, other goroutines call Do wait f returns.
+// The once is kept by the scheduler only while Do is running.
+func schedOnceDo(fr *frame, o *sync.Once, f func()) {
+	s := fr.interp.sched
+	g := s.current()
+	if g == nil {
+		o.Do(f)
+		return
+	}
+	s.yield(g)
+	s.wait(g, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.onces[o] {
+			return false
+		}
+		s.onces[o] = true
+		return true
+	})
+	defer func() {
+		s.mu.Lock()
+		delete(s.onces, o)
+		s.mu.Unlock()
+	}()
+	r := fr.interp.race
+	if r == nil {
+		o.Do(f)
+		return
+	}
+	o.Do(func() {
+		f()
+		r.release(uintptr(unsafe.Pointer(o)))
+	})
+	r.acquire(uintptr(unsafe.Pointer(o)))
+}
+
+// schedCondWait wait the signal by the scheduler, the waiters are woken in
+// FIFO order like sync.Cond.
+func schedCondWait(fr *frame, c *sync.Cond) {
+	s := fr.interp.sched
+	g := s.current()
+	if g == nil {
+		c.Wait()
+		return
+	}
+	s.mu.Lock()
+	g.notified = false
+	s.conds[c] = append(s.conds[c], g)
+	s.mu.Unlock()
+	c.L.Unlock()
+	s.yield(g)
+	s.wait(g, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return g.notified
+	})
+	switch l := c.L.(type) {
+	case *sync.Mutex:
+		schedMutexLock(fr, l)
+	case *sync.RWMutex:
+		schedRWMutexLock(fr, l)
+	default:
+		l.Lock()
+	}
+}
+
+func schedCondSignal(fr *frame, c *sync.Cond) {
+	s := fr.interp.sched
+	s.mu.Lock()
+	if ws := s.conds[c]; len(ws) > 0 {
+		ws[0].notified = true
+		s.conds[c] = ws[1:]
+	}
+	s.mu.Unlock()
+	schedUnlock(fr, c.Signal)
+}
+
+func schedCondBroadcast(fr *frame, c *sync.Cond) {
+	s := fr.interp.sched
+	s.mu.Lock()
+	for _, g := range s.conds[c] {
+		g.notified = true
+	}
+	delete(s.conds, c)
+	s.mu.Unlock()
+	schedUnlock(fr, c.Broadcast)
+}
+
+type schedG struct {
+	id       int64                // goroutine id
+	run      chan struct{}        // resume to run
+	blocked  bool                 // blocked since last progress
+	steps    int                  // instructions run since last switch
+	pending  []reflect.SelectCase // channel operations blocked
+	match    int                  // index of pending case matched by other goroutine, -1 if none
+	notified bool                 // signaled by sync.Cond
+}
+
+// scheduler run the interp goroutines cooperatively, only one goroutine
+// hold the schedule and run at a time. The goroutine switch at channel
+// operations, sync calls, runtime.Gosched and every quantum instructions,
+// the next goroutine, select case and map iteration order are chosen by
+// the seeded rand, so the same seed reproduce the same interleaving.
+// When all goroutines are blocked the scheduler advance the auto advance
+// VirtualClock to the next timer.
+//
+// The determinism breaks at: goroutines blocked on native calls hold the
+// schedule until return; real timers, I/O and native goroutines wake up
+// the blocked goroutines by wall time; map keys of pointers not allocated
+// by the interpreted code are ordered by address.
+type scheduler struct {
+	interp  *Interp
+	mu      sync.Mutex
+	rand    *rand.Rand
+	quantum int
+	goids   sync.Map // goroutine id -> *schedG
+	gs      []*schedG
+	running *schedG
+	onces   map[*sync.Once]bool      // sync.Once running Do
+	conds   map[*sync.Cond][]*schedG // sync.Cond -> waiters
+	allocs  map[uintptr]uint64       // address of pointer and channel -> allocation order
+	nalloc  uint64
+}
+
+func newScheduler(interp *Interp, seed int64, quantum int) *scheduler {
+	if quantum <= 0 {
+		quantum = defaultScheduleQuantum
+	}
+	return &scheduler{
+		interp:  interp,
+		rand:    rand.New(rand.NewSource(seed)),
+		quantum: quantum,
+		onces:   make(map[*sync.Once]bool),
+		conds:   make(map[*sync.Cond][]*schedG),
+		allocs:  make(map[uintptr]uint64),
+	}
+}
+
+// current return the goroutine of scheduler, nil if not start by interp.
+func (s *scheduler) current() *schedG {
+	if s == nil {
+		return nil
+	}
+	if v, ok := s.goids.Load(goroutineID()); ok {
+		return v.(*schedG)
+	}
+	return nil
+}
+
+// enter attach the current goroutine to scheduler.
+func (s *scheduler) enter() *schedG {
+	g := &schedG{id: goroutineID(), run: make(chan struct{}, 1), match: -1}
+	s.goids.Store(g.id, g)
+	s.attach(g)
+	return g
+}
+
+func (s *scheduler) exit(g *schedG) {
+	s.detach(g)
+	s.goids.Delete(g.id)
+}
+
+// spawn create goroutine for go statement by the running goroutine, so the
+// order of goroutines is same for the seed.
+func (s *scheduler) spawn() *schedG {
+	g := &schedG{run: make(chan struct{}, 1), match: -1}
+	s.mu.Lock()
+	s.gs = append(s.gs, g)
+	// go statement by goroutine not in scheduler
+	if s.running == nil {
+		s.running = g
+		g.run <- struct{}{}
+	}
+	s.mu.Unlock()
+	return g
+}
+
+// start bind the spawned goroutine to current goroutine and wait to run.
+func (s *scheduler) start(g *schedG) {
+	g.id = goroutineID()
+	s.goids.Store(g.id, g)
+	<-g.run
+}
+
+// attach add goroutine to scheduler and wait to run.
+func (s *scheduler) attach(g *schedG) {
+	s.mu.Lock()
+	s.gs = append(s.gs, g)
+	if s.running == nil {
+		s.running = g
+		s.mu.Unlock()
+		return
+	}
+	s.mu.Unlock()
+	<-g.run
+}
+
+// detach remove goroutine from scheduler and run the next goroutine.
+func (s *scheduler) detach(g *schedG) {
+	s.mu.Lock()
+	for i, v := range s.gs {
+		if v == g {
+			s.gs = append(s.gs[:i], s.gs[i+1:]...)
+			break
+		}
+	}
+	s.progress()
+	next := s.pick()
+	s.running = next
+	s.mu.Unlock()
+	if next != nil {
+		next.run <- struct{}{}
+	}
+}
+
+// progress reset blocked goroutines to retry.
+func (s *scheduler) progress() {
+	for _, g := range s.gs {
+		g.blocked = false
+	}
+}
+
+// pick return the random goroutine not blocked.
+func (s *scheduler) pick() *schedG {
+	var gs []*schedG
+	for _, g := range s.gs {
+		if !g.blocked {
+			gs = append(gs, g)
+		}
+	}
+	if len(gs) == 0 {
+		return nil
+	}
+	return gs[s.rand.Intn(len(gs))]
+}
+
+// switchTo run the next goroutine and wait g to run, must hold the lock.
+func (s *scheduler) switchTo(g *schedG, next *schedG) {
+	g.steps = 0
+	if next == g {
+		s.mu.Unlock()
+		return
+	}
+	s.running = next
+	s.mu.Unlock()
+	next.run <- struct{}{}
+	<-g.run
+}
+
+// yield give up the schedule and run the random goroutine.
+func (s *scheduler) yield(g *schedG) {
+	s.mu.Lock()
+	s.progress()
+	s.switchTo(g, s.pick())
+}
+
+// block mark g blocked and run other goroutine, if all goroutines are
+// blocked advance the virtual clock or sleep for native wake up and retry all.
+func (s *scheduler) block(g *schedG, progress bool) {
+	s.mu.Lock()
+	if progress {
+		s.progress()
+	}
+	g.blocked = true
+	next := s.pick()
+	if next == nil {
+		s.progress()
+		s.mu.Unlock()
+		if c := s.interp.clock; c == nil || !c.advanceIdle() {
+			time.Sleep(schedulePoll)
+		}
+		s.mu.Lock()
+		next = s.pick()
+	}
+	s.switchTo(g, next)
+}
+
+// wait poll fn until it return true, return false if interp aborted.
+func (s *scheduler) wait(g *schedG, fn func() bool) bool {
+	for first := true; !fn(); first = false {
+		select {
+		case <-s.interp.chabort:
+			return false
+		default:
+		}
+		s.block(g, first)
+	}
+	return true
+}
+
+// step count n instructions run and preempt by quantum.
+func (s *scheduler) step(n int) {
+	g := s.current()
+	if g == nil {
+		return
+	}
+	g.steps += n
+	if g.steps >= s.quantum {
+		s.yield(g)
+	}
+}
+
+// instr count the instructions of block at the first instruction.
+func (s *scheduler) instr(n int, ifn func(fr *frame)) func(fr *frame) {
+	return func(fr *frame) {
+		s.step(n)
+		ifn(fr)
+	}
+}
+
+// alloc record the allocation order of pointer and channel made by instr,
+// the map keys of them are sorted by the order instead of address. The
+// order is removed when the memory is freed.
+func (s *scheduler) alloc(ir register, ifn func(fr *frame)) func(fr *frame) {
+	return func(fr *frame) {
+		ifn(fr)
+		v := reflect.ValueOf(fr.reg(ir))
+		if p := v.Pointer(); p != 0 {
+			s.mu.Lock()
+			s.nalloc++
+			s.allocs[p] = s.nalloc
+			a := schedAlloc{p, s.nalloc}
+			s.mu.Unlock()
+			s.cleanup(v, a)
+		}
+	}
+}
+
+// schedAlloc is the allocation order of address.
+type schedAlloc struct {
+	p   uintptr
+	seq uint64
+}
+
+// free remove the allocation order of a, the address may be allocated again
+// before the cleanup of freed memory.
+func (s *scheduler) free(a schedAlloc) {
+	s.mu.Lock()
+	if s.allocs[a.p] == a.seq {
+		delete(s.allocs, a.p)
+	}
+	s.mu.Unlock()
+}
+
+// perm return the random permutation of n.
+func (s *scheduler) perm(n int) []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rand.Perm(n)
+}
+
+// trySelect try cases in random order without blocking, then rendezvous
+// with the goroutine blocked on the other side of the channel. Return -1 if
+// no case ready.
+func (s *scheduler) trySelect(g *schedG, cases []reflect.SelectCase) (int, reflect.Value, bool) {
+	perm := s.perm(len(cases))
+	for _, i := range perm {
+		c := cases[i]
+		if c.Chan.IsNil() {
+			continue
+		}
+		switch c.Dir {
+		case reflect.SelectSend:
+			if c.Chan.TrySend(c.Send) {
+				return i, reflect.Value{}, false
+			}
+		case reflect.SelectRecv:
+			if v, ok := c.Chan.TryRecv(); ok || v.IsValid() {
+				return i, v, ok
+			}
+		}
+	}
+	for _, i := range perm {
+		c := cases[i]
+		if c.Chan.IsNil() || !s.rendezvous(g, c) {
+			continue
+		}
+		if c.Dir == reflect.SelectSend {
+			c.Chan.Send(c.Send)
+			return i, reflect.Value{}, false
+		}
+		v, ok := c.Chan.Recv()
+		return i, v, ok
+	}
+	return -1, reflect.Value{}, false
+}
+
+// rendezvous find the random goroutine blocked on the other side of case c
+// and wake it to complete the matched case, the unbuffered channel can't
+// complete by try without blocking.
+func (s *scheduler) rendezvous(g *schedG, c reflect.SelectCase) bool {
+	type match struct {
+		g     *schedG
+		index int
+	}
+	var ms []match
+	s.mu.Lock()
+	for _, o := range s.gs {
+		if o == g {
+			continue
+		}
+		for j, oc := range o.pending {
+			if oc.Dir != c.Dir && !oc.Chan.IsNil() && oc.Chan.Pointer() == c.Chan.Pointer() {
+				ms = append(ms, match{o, j})
+			}
+		}
+	}
+	if len(ms) == 0 {
+		s.mu.Unlock()
+		return false
+	}
+	m := ms[s.rand.Intn(len(ms))]
+	m.g.pending = nil
+	m.g.blocked = false
+	m.g.match = m.index
+	s.mu.Unlock()
+	m.g.run <- struct{}{}
+	return true
+}
+
+// selectWait block until one of cases ready or matched by other goroutine,
+// return -1 if interp aborted.
+func (s *scheduler) selectWait(g *schedG, cases []reflect.SelectCase) (chosen int, recv reflect.Value, recvOk bool) {
+	defer func() {
+		s.mu.Lock()
+		g.pending = nil
+		s.mu.Unlock()
+	}()
+	for first := true; ; first = false {
+		if chosen, recv, recvOk = s.trySelect(g, cases); chosen >= 0 {
+			return
+		}
+		select {
+		case <-s.interp.chabort:
+			return -1, recv, false
+		default:
+		}
+		s.mu.Lock()
+		g.pending = cases
+		s.mu.Unlock()
+		s.block(g, first)
+		s.mu.Lock()
+		chosen, g.match = g.match, -1
+		s.mu.Unlock()
+		if chosen >= 0 {
+			// complete the case matched, then wait to run
+			c := cases[chosen]
+			if c.Dir == reflect.SelectSend {
+				c.Chan.Send(c.Send)
+			} else {
+				recv, recvOk = c.Chan.Recv()
+			}
+			<-g.run
+			return
+		}
+	}
+}
+
+// mapIter return the map iterator by random order of sorted keys.
+func (s *scheduler) mapIter(m reflect.Value) *mapIter {
+	keys := m.MapKeys()
+	s.mu.Lock()
+	sort.Slice(keys, func(i, j int) bool {
+		return s.compareValue(keys[i], keys[j]) < 0
+	})
+	s.mu.Unlock()
+	perm := s.perm(len(keys))
+	shuffled := make([]reflect.Value, len(keys))
+	for i, j := range perm {
+		shuffled[i] = keys[j]
+	}
+	return &mapIter{m: m, keys: shuffled}
+}
+
+// compareValue compare the map keys of same type like internal/fmtsort,
+// must hold the lock.
+func (s *scheduler) compareValue(a, b reflect.Value) int {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x, y := a.Int(), b.Int()
+		return compareOrdered(x < y, x > y)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		x, y := a.Uint(), b.Uint()
+		return compareOrdered(x < y, x > y)
+	case reflect.String:
+		x, y := a.String(), b.String()
+		return compareOrdered(x < y, x > y)
+	case reflect.Float32, reflect.Float64:
+		x, y := a.Float(), b.Float()
+		return compareOrdered(x < y, x > y)
+	case reflect.Complex64, reflect.Complex128:
+		x, y := a.Complex(), b.Complex()
+		if c := compareOrdered(real(x) < real(y), real(x) > real(y)); c != 0 {
+			return c
+		}
+		return compareOrdered(imag(x) < imag(y), imag(x) > imag(y))
+	case reflect.Bool:
+		x, y := a.Bool(), b.Bool()
+		return compareOrdered(!x && y, x && !y)
+	case reflect.Ptr, reflect.UnsafePointer, reflect.Chan:
+		return s.comparePointer(a.Pointer(), b.Pointer())
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if c := s.compareValue(a.Field(i), b.Field(i)); c != 0 {
+				return c
+			}
+		}
+		return 0
+	case reflect.Array:
+		for i := 0; i < a.Len(); i++ {
+			if c := s.compareValue(a.Index(i), b.Index(i)); c != 0 {
+				return c
+			}
+		}
+		return 0
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			switch {
+			case a.IsNil() && b.IsNil():
+				return 0
+			case a.IsNil():
+				return -1
+			}
+			return 1
+		}
+		ea, eb := a.Elem(), b.Elem()
+		if ea.Type() != eb.Type() {
+			sa, sb := ea.Type().String(), eb.Type().String()
+			return compareOrdered(sa < sb, sa > sb)
+		}
+		return s.compareValue(ea, eb)
+	}
+	return 0
+}
+
+// comparePointer compare by allocation order, the pointers not allocated
+// by interp are ordered by address after them.
+func (s *scheduler) comparePointer(x, y uintptr) int {
+	ix, okx := s.allocs[x]
+	iy, oky := s.allocs[y]
+	switch {
+	case okx && oky:
+		return compareOrdered(ix < iy, ix > iy)
+	case okx:
+		return -1
+	case oky:
+		return 1
+	}
+	return compareOrdered(x < y, x > y)
+}
+
+func compareOrdered(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,46 @@
+//go:build go1.18
+// +build go1.18
+
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import "sync"
+
+// schedLock poll tryLock as blocking operation.
+func schedLock(fr *frame, tryLock func() bool, lock func()) {
+	s := fr.interp.sched
+	g := s.current()
+	if g == nil {
+		lock()
+		return
+	}
+	s.yield(g)
+	s.wait(g, tryLock)
+}
+
+func schedMutexLock(fr *frame, m *sync.Mutex) {
+	schedLock(fr, m.TryLock, m.Lock)
+}
+
+func schedRWMutexLock(fr *frame, m *sync.RWMutex) {
+	schedLock(fr, m.TryLock, m.Lock)
+}
+
+func schedRWMutexRLock(fr *frame, m *sync.RWMutex) {
+	schedLock(fr, m.TryRLock, m.RLock)
+}
@@ -0,0 +1,33 @@
+//go:build go1.24
+// +build go1.24
+
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"reflect"
+	"runtime"
+)
+
+// cleanup remove the allocation order a when the memory of v is freed.
+func (s *scheduler) cleanup(v reflect.Value, a schedAlloc) {
+	if v.Kind() == reflect.Ptr && v.Type().Elem().Size() == 0 {
+		return
+	}
+	runtime.AddCleanup((*byte)(v.UnsafePointer()), s.free, a)
+}
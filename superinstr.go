@@ -0,0 +1,344 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"reflect"
+
+	"github.com/goplus/reflectx"
+	"golang.org/x/tools/go/ssa"
+)
+
+// maxFusedPhis is the max count of phis in block fused to one instr.
+const maxFusedPhis = 8
+
+// makeSuperInstr make the fused instr of common patterns start at instrs[i],
+// return nil if not match. The followed instrs are still compiled, the fused
+// instr run them and skip their pc.
+func makeSuperInstr(pfn *function, instrs []ssa.Instruction, i int) func(fr *frame) {
+	switch instr := instrs[i].(type) {
+	case *ssa.Phi:
+		return makeSuperPhis(pfn, instrs, i)
+	case *ssa.BinOp:
+		if i+1 < len(instrs) && pfn.onlyReferrer(instr, instrs[i+1]) {
+			if next, ok := instrs[i+1].(*ssa.If); ok {
+				return makeSuperCompareIf(pfn, instr, next)
+			}
+		}
+		return makeSuperBinOpConst(pfn, instr)
+	case *ssa.IndexAddr:
+		if i+1 < len(instrs) && pfn.onlyReferrer(instr, instrs[i+1]) {
+			if next, ok := instrs[i+1].(*ssa.UnOp); ok && next.Op == token.MUL {
+				return makeSuperIndexLoad(pfn, instr, next)
+			}
+		}
+	case *ssa.FieldAddr:
+		if i+1 < len(instrs) && pfn.onlyReferrer(instr, instrs[i+1]) {
+			if next, ok := instrs[i+1].(*ssa.Store); ok && pfn.value(next.Addr) == instr {
+				return makeSuperFieldStore(pfn, instr, next)
+			}
+		}
+	}
+	return nil
+}
+
+// onlyReferrer check next is the only referrer of v.
+func (p *function) onlyReferrer(v ssa.Value, next ssa.Instruction) bool {
+	refs := p.referrers(v)
+	return len(refs) == 1 && refs[0] == next
+}
+
+// phiCopy is the copy of phi value on edge.
+type phiCopy struct {
+	kind int           // 0 boxed, 1 unboxed int, 2 unboxed float
+	dst  int           // register or index of banks
+	src  register      // register of boxed edge
+	box  func(*frame)  // box the unboxed edge before read src
+	i    *intOperand   // edge of unboxed int
+	f    *floatOperand // edge of unboxed float
+}
+
+// makeSuperPhis fuse the phis of block, the phis are assigned in parallel.
+func makeSuperPhis(pfn *function, instrs []ssa.Instruction, i int) func(fr *frame) {
+	if i != 0 {
+		return nil
+	}
+	var phis []*ssa.Phi
+	for _, instr := range instrs {
+		phi, ok := instr.(*ssa.Phi)
+		if !ok {
+			break
+		}
+		phis = append(phis, phi)
+	}
+	if len(phis) < 2 || len(phis) > maxFusedPhis {
+		return nil
+	}
+	preds := phis[0].Block().Preds
+	copies := make([][]phiCopy, len(preds))
+	for n := range preds {
+		for _, phi := range phis {
+			edge := phi.Edges[n]
+			var c phiCopy
+			if bank, ok := pfn.unboxed[phi]; ok {
+				c.dst = bank
+				if isUnboxFloat(unboxKind(phi.Type())) {
+					c.kind = 2
+					c.f = pfn.floatOperand(edge)
+				} else {
+					c.kind = 1
+					c.i = pfn.intOperand(edge)
+				}
+			} else {
+				c.dst = int(pfn.regIndex(phi))
+				c.src = pfn.regIndex(edge)
+				if bank, ok := pfn.unboxed[pfn.value(edge)]; ok {
+					c.box = pfn.makeBox(edge, bank)
+				}
+			}
+			copies[n] = append(copies[n], c)
+		}
+	}
+	skip := len(phis) - 1
+	return func(fr *frame) {
+		for n, pred := range preds {
+			if fr.pred != pred.Index {
+				continue
+			}
+			var ints [maxFusedPhis]uint64
+			var floats [maxFusedPhis]float64
+			var values [maxFusedPhis]value
+			cs := copies[n]
+			for k := range cs {
+				switch c := &cs[k]; c.kind {
+				case 1:
+					ints[k] = c.i.get(fr)
+				case 2:
+					floats[k] = c.f.get(fr)
+				default:
+					if c.box != nil {
+						c.box(fr)
+					}
+					values[k] = fr.reg(c.src)
+				}
+			}
+			for k := range cs {
+				switch c := &cs[k]; c.kind {
+				case 1:
+					fr.ints[c.dst] = ints[k]
+				case 2:
+					fr.floats[c.dst] = floats[k]
+				default:
+					fr.setReg(register(c.dst), values[k])
+				}
+			}
+			break
+		}
+		fr.ipc += skip
+	}
+}
+
+// makeSuperCompareIf fuse the compare and If use it.
+func makeSuperCompareIf(pfn *function, instr *ssa.BinOp, next *ssa.If) func(fr *frame) {
+	if !canUnboxOp(instr) {
+		return nil
+	}
+	switch instr.Op {
+	case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+	default:
+		return nil
+	}
+	var cond func(fr *frame) bool
+	if isUnboxFloat(unboxKind(instr.X.Type())) {
+		cond = makeFloatCond(pfn, instr)
+	} else {
+		cond = makeIntCond(pfn, instr)
+	}
+	return func(fr *frame) {
+		fr.pred = fr.block.Index
+		if cond(fr) {
+			fr.block = fr.block.Succs[0]
+		} else {
+			fr.block = fr.block.Succs[1]
+		}
+		fr.ipc = fr.pfn.Blocks[fr.block.Index]
+	}
+}
+
+func makeIntCond(pfn *function, instr *ssa.BinOp) func(fr *frame) bool {
+	x, y := pfn.intOperand(instr.X), pfn.intOperand(instr.Y)
+	// signed compare as unsigned by flip the sign bit
+	var bias uint64
+	switch unboxKind(instr.X.Type()) {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		bias = 1 << 63
+	}
+	if ix := x.bank; ix >= 0 && y.bank < 0 && y.load == nil {
+		c := y.c ^ bias
+		switch instr.Op {
+		case token.EQL:
+			return func(fr *frame) bool { return fr.ints[ix]^bias == c }
+		case token.NEQ:
+			return func(fr *frame) bool { return fr.ints[ix]^bias != c }
+		case token.LSS:
+			return func(fr *frame) bool { return fr.ints[ix]^bias < c }
+		case token.LEQ:
+			return func(fr *frame) bool { return fr.ints[ix]^bias <= c }
+		case token.GTR:
+			return func(fr *frame) bool { return fr.ints[ix]^bias > c }
+		case token.GEQ:
+			return func(fr *frame) bool { return fr.ints[ix]^bias >= c }
+		}
+	}
+	switch instr.Op {
+	case token.EQL:
+		return func(fr *frame) bool { return x.get(fr) == y.get(fr) }
+	case token.NEQ:
+		return func(fr *frame) bool { return x.get(fr) != y.get(fr) }
+	case token.LSS:
+		return func(fr *frame) bool { return x.get(fr)^bias < y.get(fr)^bias }
+	case token.LEQ:
+		return func(fr *frame) bool { return x.get(fr)^bias <= y.get(fr)^bias }
+	case token.GTR:
+		return func(fr *frame) bool { return x.get(fr)^bias > y.get(fr)^bias }
+	case token.GEQ:
+		return func(fr *frame) bool { return x.get(fr)^bias >= y.get(fr)^bias }
+	}
+	panic("unreachable")
+}
+
+func makeFloatCond(pfn *function, instr *ssa.BinOp) func(fr *frame) bool {
+	x, y := pfn.floatOperand(instr.X), pfn.floatOperand(instr.Y)
+	switch instr.Op {
+	case token.EQL:
+		return func(fr *frame) bool { return x.get(fr) == y.get(fr) }
+	case token.NEQ:
+		return func(fr *frame) bool { return x.get(fr) != y.get(fr) }
+	case token.LSS:
+		return func(fr *frame) bool { return x.get(fr) < y.get(fr) }
+	case token.LEQ:
+		return func(fr *frame) bool { return x.get(fr) <= y.get(fr) }
+	case token.GTR:
+		return func(fr *frame) bool { return x.get(fr) > y.get(fr) }
+	case token.GEQ:
+		return func(fr *frame) bool { return x.get(fr) >= y.get(fr) }
+	}
+	panic("unreachable")
+}
+
+// makeSuperBinOpConst make the unboxed integer + - of register and const.
+func makeSuperBinOpConst(pfn *function, instr *ssa.BinOp) func(fr *frame) {
+	ir, ok := pfn.unboxed[instr]
+	if !ok || (instr.Op != token.ADD && instr.Op != token.SUB) {
+		return nil
+	}
+	kind := unboxKind(instr.X.Type())
+	if isUnboxFloat(kind) {
+		return nil
+	}
+	x, y := pfn.intOperand(instr.X), pfn.intOperand(instr.Y)
+	ix := x.bank
+	if ix < 0 || y.bank >= 0 || y.load != nil {
+		return nil
+	}
+	c := y.c
+	if instr.Op == token.SUB {
+		c = -c
+	}
+	shift := 64 - uint(unboxSize(kind)*8)
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(fr *frame) { fr.ints[ir] = uint64(int64((fr.ints[ix]+c)<<shift) >> shift) }
+	}
+	return func(fr *frame) { fr.ints[ir] = (fr.ints[ix] + c) << shift >> shift }
+}
+
+// indexOperand return the func get int index of v.
+func (p *function) indexOperand(v ssa.Value) func(fr *frame) int {
+	if kind := unboxKind(v.Type()); kind != reflect.Invalid && !isUnboxFloat(kind) {
+		o := p.intOperand(v)
+		return func(fr *frame) int { return int(o.get(fr)) }
+	}
+	ii := p.regIndex(v)
+	return func(fr *frame) int { return asInt(fr.reg(ii)) }
+}
+
+// makeSuperIndexLoad fuse the IndexAddr and load of element.
+func makeSuperIndexLoad(pfn *function, instr *ssa.IndexAddr, next *ssa.UnOp) func(fr *frame) {
+	ir := pfn.regIndex(next)
+	ix := pfn.regIndex(instr.X)
+	index := pfn.indexOperand(instr.Index)
+	if pfn.nobounds[instr] {
+		return func(fr *frame) {
+			fr.setReg(ir, reflect.ValueOf(fr.reg(ix)).Index(index(fr)).Interface())
+			fr.ipc++
+		}
+	}
+	return func(fr *frame) {
+		x := fr.reg(ix)
+		v := reflect.ValueOf(x)
+		if v.Kind() == reflect.Ptr {
+			v = v.Elem()
+		}
+		switch v.Kind() {
+		case reflect.Slice:
+		case reflect.Array:
+		case reflect.Invalid:
+			panic(runtimeError("invalid memory address or nil pointer dereference"))
+		default:
+			panic(fmt.Sprintf("unexpected x type in IndexAddr: %T", x))
+		}
+		i := index(fr)
+		if i < 0 {
+			panic(runtimeError(fmt.Sprintf("index out of range [%v]", i)))
+		} else if length := v.Len(); i >= length {
+			panic(runtimeError(fmt.Sprintf("index out of range [%v] with length %v", i, length)))
+		}
+		fr.setReg(ir, v.Index(i).Interface())
+		fr.ipc++
+	}
+}
+
+// makeSuperFieldStore fuse the FieldAddr and store to field.
+func makeSuperFieldStore(pfn *function, instr *ssa.FieldAddr, next *ssa.Store) func(fr *frame) {
+	if s, ok := instr.X.Type().Underlying().(*types.Pointer).Elem().Underlying().(*types.Struct); !ok || s.Field(instr.Field).Name() == "_" {
+		return nil
+	}
+	ix := pfn.regIndex(instr.X)
+	iv := pfn.regIndex(next.Val)
+	box := pfn.boxOperands(next)
+	field := instr.Field
+	return func(fr *frame) {
+		x := reflect.ValueOf(fr.reg(ix)).Elem()
+		if !x.IsValid() {
+			panic(runtimeError("invalid memory address or nil pointer dereference"))
+		}
+		f := reflectx.FieldX(x, field)
+		if box != nil {
+			box(fr)
+		}
+		if v := reflect.ValueOf(fr.reg(iv)); v.IsValid() {
+			SetValue(f, v)
+		} else {
+			SetValue(f, reflect.New(f.Type()).Elem())
+		}
+		fr.ipc++
+	}
+}
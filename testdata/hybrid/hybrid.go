@@ -0,0 +1,27 @@
+//go:build go1.18
+// +build go1.18
+
+package hybrid
+
+var Total int
+
+var count int
+
+func Add(n int) {
+	count++
+	Total += n
+}
+
+func scale(n int) int {
+	return n * 10
+}
+
+func Sum[T ~int](v ...T) {
+	for _, n := range v {
+		Add(scale(int(n)))
+	}
+}
+
+func Count[T ~int]() T {
+	return T(count)
+}
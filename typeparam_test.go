@@ -22,6 +22,7 @@ package igop_test
 import (
 	"bytes"
 	"fmt"
+	"reflect"
 	"runtime"
 	"testing"
 
@@ -235,6 +236,32 @@ func main() {
 	}
 }
 
+func TestNestedTypeIndex(t *testing.T) {
+	src := `package main
+
+import "fmt"
+
+func F[A any]() any {
+	type U[_ any] int
+	type T[_ any] struct{}
+	return T[U[A]]{}
+}
+
+func main() {
+	type U[_ any] int
+	type T[_ any] struct{}
+	s := fmt.Sprintf("%T %T %T", F[int](), F[string](), T[U[int]]{})
+	if s != "main.T[int;main.U[int;int]·1] main.T[string;main.U[string;string]·1] main.T[main.U[int]·3]" {
+		panic(s)
+	}
+}
+`
+	_, err := igop.RunFile("main.go", src, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestTypeParamsRecursive(t *testing.T) {
 	src := `package main
 
@@ -343,3 +370,59 @@ func main() {
 		t.Fatal(err)
 	}
 }
+
+func TestHybridPackage(t *testing.T) {
+	var total int
+	igop.RegisterPackage(&igop.Package{
+		Name:       "hybrid",
+		Path:       "github.com/goplus/igop/testdata/hybrid",
+		Deps:       map[string]string{},
+		Interfaces: map[string]reflect.Type{},
+		NamedTypes: map[string]reflect.Type{},
+		AliasTypes: map[string]reflect.Type{},
+		Vars: map[string]reflect.Value{
+			"Total": reflect.ValueOf(&total),
+		},
+		Funcs: map[string]reflect.Value{
+			"Add": reflect.ValueOf(func(n int) { total += n }),
+		},
+		TypedConsts:   map[string]igop.TypedConst{},
+		UntypedConsts: map[string]igop.UntypedConst{},
+		Source: `package hybrid
+
+var Total int
+
+func Add(n int)
+
+func scale(n int) int {
+	return n * 10
+}
+
+func Sum[T ~int](v ...T) {
+	for _, n := range v {
+		Add(scale(int(n)))
+	}
+}
+`,
+	})
+	src := `package main
+
+import "github.com/goplus/igop/testdata/hybrid"
+
+type N int
+
+func main() {
+	hybrid.Sum[N](1, 2, 3)
+	if hybrid.Total != 60 {
+		panic(hybrid.Total)
+	}
+}
+`
+	_, err := igop.RunFile("main.go", src, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 60 {
+		t.Fatalf("native var not shared: %v", total)
+	}
+}
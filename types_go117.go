@@ -35,6 +35,10 @@ func hasTypeParam(t types.Type) bool {
 	return false
 }
 
+func namedTypeArgs(t *types.Named) []types.Type {
+	return nil
+}
+
 type nestedStack struct {
 }
 
@@ -80,3 +84,6 @@ func newTypesInfo() *types.Info {
 		Selections: make(map[*ast.SelectorExpr]*types.Selection),
 	}
 }
+
+func setGoVersion(conf *types.Config, version string) {
+}
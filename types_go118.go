@@ -21,6 +21,7 @@ package igop
 
 import (
 	"go/ast"
+	"go/build"
 	"go/types"
 	"reflect"
 	"strconv"
@@ -46,6 +47,14 @@ func hasTypeParam(t types.Type) bool {
 	return false
 }
 
+func namedTypeArgs(t *types.Named) (targs []types.Type) {
+	list := t.TypeArgs()
+	for i := 0; i < list.Len(); i++ {
+		targs = append(targs, list.At(i))
+	}
+	return
+}
+
 type nestedStack struct {
 	targs []string
 	cache []*typeutil.Map
@@ -74,13 +83,22 @@ func (r *TypesRecord) typeId(typ types.Type, t reflect.Type) string {
 	}
 	name := path + "." + t.Name()
 	if named, ok := typ.(*types.Named); ok {
-		if n := r.nested[named.Origin()]; n != 0 {
+		if n := r.nestedIndex(named); n != 0 {
 			name += "·" + strconv.Itoa(n)
 		}
 	}
 	return name
 }
 
+// nestedIndex return the index of local named type, the local types of
+// generic func instantiated are new types of same position by ssa.
+func (r *TypesRecord) nestedIndex(named *types.Named) int {
+	if n := r.nested[named.Origin()]; n != 0 {
+		return n
+	}
+	return r.npos[named.Origin().Obj().Pos()]
+}
+
 func (r *TypesRecord) EnterInstance(fn *ssa.Function) {
 	r.ncache = &typeutil.Map{}
 	tp := fn.TypeParams()
@@ -200,7 +218,7 @@ func (r *TypesLoader) hasTypeArgs(rt reflect.Type) bool {
 }
 
 func newTypesInfo() *types.Info {
-	return &types.Info{
+	info := &types.Info{
 		Types:      make(map[ast.Expr]types.TypeAndValue),
 		Defs:       make(map[*ast.Ident]types.Object),
 		Uses:       make(map[*ast.Ident]types.Object),
@@ -209,4 +227,16 @@ func newTypesInfo() *types.Info {
 		Selections: make(map[*ast.SelectorExpr]*types.Selection),
 		Instances:  make(map[*ast.Ident]types.Instance),
 	}
+	initFileVersions(info)
+	return info
+}
+
+// setGoVersion set the language version of type checker, the version of
+// toolchain is used if empty, so the files can downgrade by build constraint.
+func setGoVersion(conf *types.Config, version string) {
+	if version == "" {
+		tags := build.Default.ReleaseTags
+		version = tags[len(tags)-1]
+	}
+	conf.GoVersion = version
 }
@@ -0,0 +1,39 @@
+//go:build go1.22
+// +build go1.22
+
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"go/ast"
+	"go/types"
+)
+
+// unalias return the actual type of alias type.
+func unalias(typ types.Type) (types.Type, bool) {
+	if t, ok := typ.(*types.Alias); ok {
+		return types.Unalias(t), true
+	}
+	return typ, false
+}
+
+// initFileVersions record the go version of files, ssa build loop vars
+// per-iteration since go1.22.
+func initFileVersions(info *types.Info) {
+	info.FileVersions = make(map[*ast.File]string)
+}
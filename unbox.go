@@ -0,0 +1,534 @@
+/*
+ * Copyright (c) 2022 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package igop
+
+import (
+	"go/token"
+	"go/types"
+	"reflect"
+	"unsafe"
+
+	"golang.org/x/tools/go/ssa"
+)
+
+// The scalar BinOp and Phi values are stored unboxed in the typed banks of
+// frame if they are used by unboxed BinOp, Phi or If. The integer and bool
+// values are stored in frame.ints, the integer is sign-extended for signed
+// kinds and zero-extended for unsigned kinds. The float values are stored in
+// frame.floats. The other instrs use them boxed to register before run.
+
+// unboxKind return the reflect kind of unnamed basic type can be unboxed,
+// return reflect.Invalid if not.
+func unboxKind(typ types.Type) reflect.Kind {
+	t, ok := typ.(*types.Basic)
+	if !ok {
+		return reflect.Invalid
+	}
+	switch t.Kind() {
+	case types.Bool:
+		return reflect.Bool
+	case types.Int:
+		return reflect.Int
+	case types.Int8:
+		return reflect.Int8
+	case types.Int16:
+		return reflect.Int16
+	case types.Int32:
+		return reflect.Int32
+	case types.Int64:
+		return reflect.Int64
+	case types.Uint:
+		return reflect.Uint
+	case types.Uint8:
+		return reflect.Uint8
+	case types.Uint16:
+		return reflect.Uint16
+	case types.Uint32:
+		return reflect.Uint32
+	case types.Uint64:
+		return reflect.Uint64
+	case types.Uintptr:
+		return reflect.Uintptr
+	case types.Float32:
+		return reflect.Float32
+	case types.Float64:
+		return reflect.Float64
+	}
+	return reflect.Invalid
+}
+
+func isUnboxFloat(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+// canUnboxOp check the BinOp operate on unboxed banks.
+func canUnboxOp(instr *ssa.BinOp) bool {
+	kind := unboxKind(instr.X.Type())
+	if kind == reflect.Invalid || unboxKind(instr.Y.Type()) != kind || unboxKind(instr.Type()) == reflect.Invalid {
+		return false
+	}
+	switch instr.Op {
+	case token.EQL, token.NEQ:
+		return true
+	case token.LSS, token.LEQ, token.GTR, token.GEQ, token.ADD, token.SUB, token.MUL:
+		return kind != reflect.Bool
+	case token.QUO:
+		return isUnboxFloat(kind)
+	case token.AND, token.OR, token.XOR, token.AND_NOT:
+		return kind != reflect.Bool && !isUnboxFloat(kind)
+	}
+	return false
+}
+
+// unboxValues return the bank index of values stored unboxed.
+func unboxValues(pfn *function) (index map[ssa.Value]int, nints int, nfloats int) {
+	set := make(map[ssa.Value]bool)
+	for _, b := range pfn.Fn.Blocks {
+		for _, instr := range pfn.blockInstrs(b) {
+			switch v := instr.(type) {
+			case *ssa.BinOp:
+				if canUnboxOp(v) {
+					set[v] = true
+				}
+			case *ssa.Phi:
+				if unboxKind(v.Type()) != reflect.Invalid {
+					set[v] = true
+				}
+			}
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for v := range set {
+			if !pfn.unboxReferrers(v, set) {
+				delete(set, v)
+				changed = true
+			}
+		}
+	}
+	if len(set) == 0 {
+		return
+	}
+	index = make(map[ssa.Value]int)
+	for _, b := range pfn.Fn.Blocks {
+		for _, instr := range pfn.blockInstrs(b) {
+			v, ok := instr.(ssa.Value)
+			if !ok || !set[v] {
+				continue
+			}
+			if isUnboxFloat(unboxKind(v.Type())) {
+				index[v] = nfloats
+				nfloats++
+			} else {
+				index[v] = nints
+				nints++
+			}
+		}
+	}
+	return
+}
+
+// unboxReferrers check v has referrer use the unboxed value.
+func (p *function) unboxReferrers(v ssa.Value, set map[ssa.Value]bool) bool {
+	for _, ref := range p.referrers(v) {
+		switch ref := ref.(type) {
+		case *ssa.BinOp:
+			if set[ref] {
+				return true
+			}
+		case *ssa.Phi:
+			if set[ref] {
+				return true
+			}
+		case *ssa.If:
+			return true
+		}
+	}
+	return false
+}
+
+// intOperand is the operand of unboxed integer or bool.
+type intOperand struct {
+	bank int                 // index of frame.ints, -1 if not unboxed
+	c    uint64              // const value
+	load func(*frame) uint64 // load boxed value
+}
+
+func (o *intOperand) get(fr *frame) uint64 {
+	if o.bank >= 0 {
+		return fr.ints[o.bank]
+	}
+	if o.load == nil {
+		return o.c
+	}
+	return o.load(fr)
+}
+
+// floatOperand is the operand of unboxed float.
+type floatOperand struct {
+	bank int                  // index of frame.floats, -1 if not unboxed
+	c    float64              // const value
+	load func(*frame) float64 // load boxed value
+}
+
+func (o *floatOperand) get(fr *frame) float64 {
+	if o.bank >= 0 {
+		return fr.floats[o.bank]
+	}
+	if o.load == nil {
+		return o.c
+	}
+	return o.load(fr)
+}
+
+func (p *function) intOperand(v ssa.Value) *intOperand {
+	v = p.value(v)
+	if i, ok := p.unboxed[v]; ok {
+		return &intOperand{bank: i}
+	}
+	o := &intOperand{bank: -1}
+	ir, kind, c := p.regIndex3(v)
+	if kind == kindConst {
+		rv := reflect.ValueOf(c)
+		switch unboxKind(v.Type()) {
+		case reflect.Bool:
+			if rv.IsValid() && rv.Bool() {
+				o.c = 1
+			}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if rv.IsValid() {
+				o.c = uint64(rv.Int())
+			}
+		default:
+			if rv.IsValid() {
+				o.c = rv.Uint()
+			}
+		}
+		return o
+	}
+	switch unboxKind(v.Type()) {
+	case reflect.Bool:
+		o.load = func(fr *frame) uint64 {
+			if fr.bool(ir) {
+				return 1
+			}
+			return 0
+		}
+	case reflect.Int:
+		o.load = func(fr *frame) uint64 { return uint64(fr.int(ir)) }
+	case reflect.Int8:
+		o.load = func(fr *frame) uint64 { return uint64(fr.int8(ir)) }
+	case reflect.Int16:
+		o.load = func(fr *frame) uint64 { return uint64(fr.int16(ir)) }
+	case reflect.Int32:
+		o.load = func(fr *frame) uint64 { return uint64(fr.int32(ir)) }
+	case reflect.Int64:
+		o.load = func(fr *frame) uint64 { return uint64(fr.int64(ir)) }
+	case reflect.Uint:
+		o.load = func(fr *frame) uint64 { return uint64(fr.uint(ir)) }
+	case reflect.Uint8:
+		o.load = func(fr *frame) uint64 { return uint64(fr.uint8(ir)) }
+	case reflect.Uint16:
+		o.load = func(fr *frame) uint64 { return uint64(fr.uint16(ir)) }
+	case reflect.Uint32:
+		o.load = func(fr *frame) uint64 { return uint64(fr.uint32(ir)) }
+	case reflect.Uint64:
+		o.load = func(fr *frame) uint64 { return fr.uint64(ir) }
+	case reflect.Uintptr:
+		o.load = func(fr *frame) uint64 { return uint64(fr.uintptr(ir)) }
+	}
+	return o
+}
+
+func (p *function) floatOperand(v ssa.Value) *floatOperand {
+	v = p.value(v)
+	if i, ok := p.unboxed[v]; ok {
+		return &floatOperand{bank: i}
+	}
+	o := &floatOperand{bank: -1}
+	ir, kind, c := p.regIndex3(v)
+	if kind == kindConst {
+		if rv := reflect.ValueOf(c); rv.IsValid() {
+			o.c = rv.Float()
+		}
+		return o
+	}
+	if unboxKind(v.Type()) == reflect.Float32 {
+		o.load = func(fr *frame) float64 { return float64(fr.float32(ir)) }
+	} else {
+		o.load = func(fr *frame) float64 { return fr.float64(ir) }
+	}
+	return o
+}
+
+// makeUnboxedInstr make the instr of unboxed values, return nil if instr not
+// use unboxed values.
+func makeUnboxedInstr(pfn *function, instr ssa.Instruction) func(fr *frame) {
+	switch instr := instr.(type) {
+	case *ssa.BinOp:
+		if i, ok := pfn.unboxed[instr]; ok {
+			if isUnboxFloat(unboxKind(instr.X.Type())) {
+				return makeUnboxedFloatOp(pfn, instr, i)
+			}
+			return makeUnboxedIntOp(pfn, instr, i)
+		}
+	case *ssa.Phi:
+		if i, ok := pfn.unboxed[instr]; ok {
+			preds := instr.Block().Preds
+			if isUnboxFloat(unboxKind(instr.Type())) {
+				edges := make([]*floatOperand, len(instr.Edges))
+				for n, v := range instr.Edges {
+					edges[n] = pfn.floatOperand(v)
+				}
+				return func(fr *frame) {
+					for n, pred := range preds {
+						if fr.pred == pred.Index {
+							fr.floats[i] = edges[n].get(fr)
+							break
+						}
+					}
+				}
+			}
+			edges := make([]*intOperand, len(instr.Edges))
+			for n, v := range instr.Edges {
+				edges[n] = pfn.intOperand(v)
+			}
+			return func(fr *frame) {
+				for n, pred := range preds {
+					if fr.pred == pred.Index {
+						fr.ints[i] = edges[n].get(fr)
+						break
+					}
+				}
+			}
+		}
+	case *ssa.If:
+		if i, ok := pfn.unboxed[pfn.value(instr.Cond)]; ok {
+			return func(fr *frame) {
+				fr.pred = fr.block.Index
+				if fr.ints[i] != 0 {
+					fr.block = fr.block.Succs[0]
+				} else {
+					fr.block = fr.block.Succs[1]
+				}
+				fr.ipc = fr.pfn.Blocks[fr.block.Index]
+			}
+		}
+	}
+	return nil
+}
+
+func unboxSize(kind reflect.Kind) uintptr {
+	switch kind {
+	case reflect.Int8, reflect.Uint8:
+		return 1
+	case reflect.Int16, reflect.Uint16:
+		return 2
+	case reflect.Int32, reflect.Uint32:
+		return 4
+	case reflect.Int, reflect.Uint:
+		return unsafe.Sizeof(int(0))
+	case reflect.Uintptr:
+		return unsafe.Sizeof(uintptr(0))
+	}
+	return 8
+}
+
+func unboxBool(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func makeUnboxedIntOp(pfn *function, instr *ssa.BinOp, ir int) func(fr *frame) {
+	x, y := pfn.intOperand(instr.X), pfn.intOperand(instr.Y)
+	kind := unboxKind(instr.X.Type())
+	// shift to truncate and extend the result of kind
+	shift := 64 - uint(unboxSize(kind)*8)
+	signed := kind >= reflect.Int && kind <= reflect.Int64
+	if signed {
+		switch instr.Op {
+		case token.ADD:
+			return func(fr *frame) { fr.ints[ir] = uint64(int64((x.get(fr)+y.get(fr))<<shift) >> shift) }
+		case token.SUB:
+			return func(fr *frame) { fr.ints[ir] = uint64(int64((x.get(fr)-y.get(fr))<<shift) >> shift) }
+		case token.MUL:
+			return func(fr *frame) { fr.ints[ir] = uint64(int64((x.get(fr)*y.get(fr))<<shift) >> shift) }
+		}
+	}
+	switch instr.Op {
+	case token.ADD:
+		return func(fr *frame) { fr.ints[ir] = (x.get(fr) + y.get(fr)) << shift >> shift }
+	case token.SUB:
+		return func(fr *frame) { fr.ints[ir] = (x.get(fr) - y.get(fr)) << shift >> shift }
+	case token.MUL:
+		return func(fr *frame) { fr.ints[ir] = (x.get(fr) * y.get(fr)) << shift >> shift }
+	case token.AND:
+		return func(fr *frame) { fr.ints[ir] = x.get(fr) & y.get(fr) }
+	case token.OR:
+		return func(fr *frame) { fr.ints[ir] = x.get(fr) | y.get(fr) }
+	case token.XOR:
+		return func(fr *frame) { fr.ints[ir] = x.get(fr) ^ y.get(fr) }
+	case token.AND_NOT:
+		return func(fr *frame) { fr.ints[ir] = x.get(fr) &^ y.get(fr) }
+	case token.EQL:
+		return func(fr *frame) { fr.ints[ir] = unboxBool(x.get(fr) == y.get(fr)) }
+	case token.NEQ:
+		return func(fr *frame) { fr.ints[ir] = unboxBool(x.get(fr) != y.get(fr)) }
+	}
+	if signed {
+		switch instr.Op {
+		case token.LSS:
+			return func(fr *frame) { fr.ints[ir] = unboxBool(int64(x.get(fr)) < int64(y.get(fr))) }
+		case token.LEQ:
+			return func(fr *frame) { fr.ints[ir] = unboxBool(int64(x.get(fr)) <= int64(y.get(fr))) }
+		case token.GTR:
+			return func(fr *frame) { fr.ints[ir] = unboxBool(int64(x.get(fr)) > int64(y.get(fr))) }
+		case token.GEQ:
+			return func(fr *frame) { fr.ints[ir] = unboxBool(int64(x.get(fr)) >= int64(y.get(fr))) }
+		}
+	}
+	switch instr.Op {
+	case token.LSS:
+		return func(fr *frame) { fr.ints[ir] = unboxBool(x.get(fr) < y.get(fr)) }
+	case token.LEQ:
+		return func(fr *frame) { fr.ints[ir] = unboxBool(x.get(fr) <= y.get(fr)) }
+	case token.GTR:
+		return func(fr *frame) { fr.ints[ir] = unboxBool(x.get(fr) > y.get(fr)) }
+	case token.GEQ:
+		return func(fr *frame) { fr.ints[ir] = unboxBool(x.get(fr) >= y.get(fr)) }
+	}
+	panic("unreachable")
+}
+
+func makeUnboxedFloatOp(pfn *function, instr *ssa.BinOp, ir int) func(fr *frame) {
+	x, y := pfn.floatOperand(instr.X), pfn.floatOperand(instr.Y)
+	// the float32 result of + - * / computed by float64 and rounded is exact.
+	if unboxKind(instr.X.Type()) == reflect.Float32 {
+		switch instr.Op {
+		case token.ADD:
+			return func(fr *frame) { fr.floats[ir] = float64(float32(x.get(fr) + y.get(fr))) }
+		case token.SUB:
+			return func(fr *frame) { fr.floats[ir] = float64(float32(x.get(fr) - y.get(fr))) }
+		case token.MUL:
+			return func(fr *frame) { fr.floats[ir] = float64(float32(x.get(fr) * y.get(fr))) }
+		case token.QUO:
+			return func(fr *frame) { fr.floats[ir] = float64(float32(x.get(fr) / y.get(fr))) }
+		}
+	}
+	switch instr.Op {
+	case token.ADD:
+		return func(fr *frame) { fr.floats[ir] = x.get(fr) + y.get(fr) }
+	case token.SUB:
+		return func(fr *frame) { fr.floats[ir] = x.get(fr) - y.get(fr) }
+	case token.MUL:
+		return func(fr *frame) { fr.floats[ir] = x.get(fr) * y.get(fr) }
+	case token.QUO:
+		return func(fr *frame) { fr.floats[ir] = x.get(fr) / y.get(fr) }
+	}
+	// the result of compare is unboxed bool
+	switch instr.Op {
+	case token.EQL:
+		return func(fr *frame) { fr.ints[ir] = unboxBool(x.get(fr) == y.get(fr)) }
+	case token.NEQ:
+		return func(fr *frame) { fr.ints[ir] = unboxBool(x.get(fr) != y.get(fr)) }
+	case token.LSS:
+		return func(fr *frame) { fr.ints[ir] = unboxBool(x.get(fr) < y.get(fr)) }
+	case token.LEQ:
+		return func(fr *frame) { fr.ints[ir] = unboxBool(x.get(fr) <= y.get(fr)) }
+	case token.GTR:
+		return func(fr *frame) { fr.ints[ir] = unboxBool(x.get(fr) > y.get(fr)) }
+	case token.GEQ:
+		return func(fr *frame) { fr.ints[ir] = unboxBool(x.get(fr) >= y.get(fr)) }
+	}
+	panic("unreachable")
+}
+
+// boxOperands return the func box the unboxed operands of instr to register,
+// return nil if instr not use boxed unboxed values.
+func (p *function) boxOperands(instr ssa.Instruction) func(fr *frame) {
+	if p.unboxed == nil {
+		return nil
+	}
+	switch instr := instr.(type) {
+	case *ssa.BinOp, *ssa.Phi:
+		if _, ok := p.unboxed[instr.(ssa.Value)]; ok {
+			return nil
+		}
+	case *ssa.If:
+		if _, ok := p.unboxed[p.value(instr.Cond)]; ok {
+			return nil
+		}
+	}
+	var boxes []func(fr *frame)
+	for _, op := range instr.Operands(nil) {
+		if op == nil || *op == nil {
+			continue
+		}
+		v := p.value(*op)
+		if i, ok := p.unboxed[v]; ok {
+			boxes = append(boxes, p.makeBox(v, i))
+		}
+	}
+	switch len(boxes) {
+	case 0:
+		return nil
+	case 1:
+		return boxes[0]
+	}
+	return func(fr *frame) {
+		for _, box := range boxes {
+			box(fr)
+		}
+	}
+}
+
+func (p *function) makeBox(v ssa.Value, i int) func(fr *frame) {
+	ir := p.regIndex(v)
+	switch unboxKind(v.Type()) {
+	case reflect.Bool:
+		return func(fr *frame) { fr.setReg(ir, fr.ints[i] != 0) }
+	case reflect.Int:
+		return func(fr *frame) { fr.setReg(ir, int(fr.ints[i])) }
+	case reflect.Int8:
+		return func(fr *frame) { fr.setReg(ir, int8(fr.ints[i])) }
+	case reflect.Int16:
+		return func(fr *frame) { fr.setReg(ir, int16(fr.ints[i])) }
+	case reflect.Int32:
+		return func(fr *frame) { fr.setReg(ir, int32(fr.ints[i])) }
+	case reflect.Int64:
+		return func(fr *frame) { fr.setReg(ir, int64(fr.ints[i])) }
+	case reflect.Uint:
+		return func(fr *frame) { fr.setReg(ir, uint(fr.ints[i])) }
+	case reflect.Uint8:
+		return func(fr *frame) { fr.setReg(ir, uint8(fr.ints[i])) }
+	case reflect.Uint16:
+		return func(fr *frame) { fr.setReg(ir, uint16(fr.ints[i])) }
+	case reflect.Uint32:
+		return func(fr *frame) { fr.setReg(ir, uint32(fr.ints[i])) }
+	case reflect.Uint64:
+		return func(fr *frame) { fr.setReg(ir, fr.ints[i]) }
+	case reflect.Uintptr:
+		return func(fr *frame) { fr.setReg(ir, uintptr(fr.ints[i])) }
+	case reflect.Float32:
+		return func(fr *frame) { fr.setReg(ir, float32(fr.floats[i])) }
+	case reflect.Float64:
+		return func(fr *frame) { fr.setReg(ir, fr.floats[i]) }
+	}
+	panic("unreachable")
+}
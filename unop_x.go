@@ -77,12 +77,13 @@ func makeUnOpMUL(pfn *function, instr *ssa.UnOp) func(fr *frame) {
 func makeUnOpARROW(pfn *function, instr *ssa.UnOp) func(fr *frame) {
 	ir := pfn.regIndex(instr)
 	ix, kx, vx := pfn.regIndex3(instr.X)
-	typ := pfn.Interp.preToType(instr.X.Type()).Elem()
+	interp := pfn.Interp
+	typ := interp.preToType(instr.X.Type()).Elem()
 	if kx == kindGlobal {
 		x := reflect.ValueOf(vx)
 		if instr.CommaOk {
 			return func(fr *frame) {
-				v, ok := x.Recv()
+				v, ok := fr.interp.chanRecv(fr, x)
 				if !ok {
 					v = reflect.New(typ).Elem()
 				}
@@ -90,7 +91,7 @@ func makeUnOpARROW(pfn *function, instr *ssa.UnOp) func(fr *frame) {
 			}
 		}
 		return func(fr *frame) {
-			v, ok := x.Recv()
+			v, ok := fr.interp.chanRecv(fr, x)
 			if !ok {
 				v = reflect.New(typ).Elem()
 			}
@@ -100,7 +101,7 @@ func makeUnOpARROW(pfn *function, instr *ssa.UnOp) func(fr *frame) {
 	if instr.CommaOk {
 		return func(fr *frame) {
 			x := reflect.ValueOf(fr.reg(ix))
-			v, ok := x.Recv()
+			v, ok := fr.interp.chanRecv(fr, x)
 			if !ok {
 				v = reflect.New(typ).Elem()
 			}
@@ -109,7 +110,7 @@ func makeUnOpARROW(pfn *function, instr *ssa.UnOp) func(fr *frame) {
 	}
 	return func(fr *frame) {
 		x := reflect.ValueOf(fr.reg(ix))
-		v, ok := x.Recv()
+		v, ok := fr.interp.chanRecv(fr, x)
 		if !ok {
 			v = reflect.New(typ).Elem()
 		}
@@ -24,6 +24,7 @@ import (
 	"log"
 	"reflect"
 	"strings"
+	"sync/atomic"
 
 	"github.com/goplus/igop/load"
 	"github.com/visualfc/xtype"
@@ -35,7 +36,7 @@ const (
 )
 
 func checkPackages(intp *Interp, pkgs []*ssa.Package) (err error) {
-	if intp.ctx.Mode&DisableRecover == 0 {
+	if intp.conf.mode&DisableRecover == 0 {
 		defer func() {
 			if v := recover(); v != nil {
 				err = v.(error)
@@ -97,7 +98,7 @@ func (visit *visitor) program() {
 				if !chks[pkg.Path()] {
 					continue
 				}
-				if visit.intp.ctx.Mode&CheckGopOverloadFunc != 0 && obj.Pos() == token.NoPos {
+				if visit.intp.conf.mode&CheckGopOverloadFunc != 0 && obj.Pos() == token.NoPos {
 					continue
 				}
 			}
@@ -212,6 +213,10 @@ func (visit *visitor) function(fn *ssa.Function) {
 		return
 	}
 	if fn.Blocks == nil {
+		if p, ok := visit.intp.natives[fnPath]; ok && !p.ext.IsValid() {
+			// resolve the mocked native for patch before compile
+			findExternFunc(visit.intp, fn)
+		}
 		if _, ok := visit.pkgs[fn.Pkg]; ok {
 			if _, ok = findExternFunc(visit.intp, fn); !ok {
 				if sym, ok := visit.findLinkSym(fn); ok {
@@ -225,7 +230,7 @@ func (visit *visitor) function(fn *ssa.Function) {
 						return
 					}
 				}
-				if visit.intp.ctx.Mode&EnableNoStrict != 0 {
+				if visit.intp.conf.mode&EnableNoStrict != 0 {
 					typ := visit.intp.preToType(fn.Type())
 					numOut := typ.NumOut()
 					if numOut == 0 {
@@ -259,20 +264,11 @@ func (visit *visitor) function(fn *ssa.Function) {
 		visit.intp.loadType(deref(alloc.Type()))
 	}
 	pfn := visit.intp.loadFunction(fn)
-	for _, p := range fn.Params {
-		pfn.regIndex(p)
-	}
-	for _, p := range fn.FreeVars {
-		pfn.regIndex(p)
-	}
 	var buf [32]*ssa.Value // avoid alloc in common case
+	var n int
 	for _, b := range fn.Blocks {
-		Instrs := make([]func(*frame), len(b.Instrs))
-		ssaInstrs := make([]ssa.Instruction, len(b.Instrs))
-		var index int
-		n := len(b.Instrs)
-		for i := 0; i < n; i++ {
-			instr := b.Instrs[i]
+		n += len(b.Instrs)
+		for _, instr := range b.Instrs {
 			ops := instr.Operands(buf[:0])
 			switch instr := instr.(type) {
 			case *ssa.Alloc:
@@ -311,27 +307,96 @@ func (visit *visitor) function(fn *ssa.Function) {
 					visit.intp.loadType(v.Type())
 				}
 			}
+		}
+	}
+	pfn.base = visit.base
+	visit.base += n + 2
+	if visit.intp.conf.mode&EagerCompile != 0 || fn.Synthetic == "package initializer" {
+		pfn.build()
+	}
+}
+
+// compile make the instrs of function on the first call if not EagerCompile,
+// it is safe for concurrent calls.
+func (pfn *function) compile() {
+	if atomic.LoadInt32(&pfn.compiled) == 1 {
+		return
+	}
+	pfn.Interp.typesMutex.Lock()
+	defer pfn.Interp.typesMutex.Unlock()
+	pfn.build()
+}
+
+// build make the instrs of function, the types and functions it used are
+// loaded by visitor. It must hold typesMutex after interp created.
+func (pfn *function) build() {
+	if atomic.LoadInt32(&pfn.compiled) == 1 {
+		return
+	}
+	interp := pfn.Interp
+	fn := pfn.Fn
+	if len(fn.TypeArgs()) != 0 {
+		interp.record.EnterInstance(fn)
+		defer interp.record.LeaveInstance(fn)
+	}
+	if level := interp.conf.optLevel; level > 0 {
+		optimizeFunc(pfn, level)
+	}
+	pfn.unboxed, pfn.nints, pfn.nfloats = unboxValues(pfn)
+	for _, p := range fn.Params {
+		pfn.regIndex(p)
+	}
+	for _, p := range fn.FreeVars {
+		pfn.regIndex(p)
+	}
+	inline := interp.conf.mode&(DisableInline|EnableTracing|CheckDataRace) == 0 && interp.conf.debugFunc == nil
+	for _, b := range fn.Blocks {
+		instrs := pfn.blockInstrs(b)
+		Instrs := make([]func(*frame), len(instrs))
+		ssaInstrs := make([]ssa.Instruction, len(instrs))
+		var index int
+		for i, instr := range instrs {
 			pfn.makeInstr = instr
-			ifn := makeInstr(visit.intp, pfn, instr)
+			ifn := makeInstr(interp, pfn, instr)
 			if ifn == nil {
 				continue
 			}
-			if visit.intp.ctx.evalMode && fn.String() == "main.init" {
-				if visit.intp.ctx.evalInit == nil {
-					visit.intp.ctx.evalInit = make(map[string]bool)
+			var sfn func(*frame)
+			if interp.conf.mode&(DisableSuperInstr|EnableTracing|CheckDataRace) == 0 {
+				sfn = makeSuperInstr(pfn, instrs, i)
+			}
+			if sfn != nil {
+				ifn = sfn
+			} else {
+				if call, ok := instr.(*ssa.Call); ok && inline {
+					if inl := makeInlineCall(interp, pfn, call, ifn); inl != nil {
+						ifn = inl
+					}
+				}
+				if box := pfn.boxOperands(instr); box != nil {
+					next := ifn
+					ifn = func(fr *frame) {
+						box(fr)
+						next(fr)
+					}
+				}
+			}
+			if interp.conf.evalMode && fn.String() == "main.init" {
+				if interp.ctx.evalInit == nil {
+					interp.ctx.evalInit = make(map[string]bool)
 				}
 				if call, ok := instr.(*ssa.Call); ok {
 					key := call.String()
 					if strings.HasPrefix(key, "init#") {
-						if visit.intp.ctx.evalInit[key] {
+						if interp.ctx.evalInit[key] {
 							ifn = func(fr *frame) {}
 						} else {
-							visit.intp.ctx.evalInit[key] = true
+							interp.ctx.evalInit[key] = true
 						}
 					}
 				}
 			}
-			if visit.intp.ctx.evalCallFn != nil {
+			if interp.ctx.evalCallFn != nil {
 				if call, ok := instr.(*ssa.Call); ok {
 					ir := pfn.regIndex(call)
 					results := call.Call.Signature().Results()
@@ -340,23 +405,39 @@ func (visit *visitor) function(fn *ssa.Function) {
 					case 0:
 						ifn = func(fr *frame) {
 							ofn(fr)
-							visit.intp.ctx.evalCallFn(visit.intp, call)
+							interp.ctx.evalCallFn(interp, call)
 						}
 					case 1:
 						ifn = func(fr *frame) {
 							ofn(fr)
-							visit.intp.ctx.evalCallFn(visit.intp, call, fr.reg(ir))
+							interp.ctx.evalCallFn(interp, call, fr.reg(ir))
 						}
 					default:
 						ifn = func(fr *frame) {
 							ofn(fr)
 							r := fr.reg(ir).(tuple)
-							visit.intp.ctx.evalCallFn(visit.intp, call, r...)
+							interp.ctx.evalCallFn(interp, call, r...)
 						}
 					}
 				}
 			}
-			if visit.intp.ctx.Mode&EnableTracing != 0 {
+			if race := interp.race; race != nil {
+				ifn = race.instr(pfn, instr, ifn)
+			}
+			if s := interp.sched; s != nil {
+				switch instr := instr.(type) {
+				case *ssa.Alloc:
+					if instr.Heap {
+						ifn = s.alloc(pfn.regIndex(instr), ifn)
+					}
+				case *ssa.MakeChan:
+					ifn = s.alloc(pfn.regIndex(instr), ifn)
+				}
+				if index == 0 {
+					ifn = s.instr(len(instrs), ifn)
+				}
+			}
+			if interp.conf.mode&EnableTracing != 0 {
 				ofn := ifn
 				ifn = func(fr *frame) {
 					if v, ok := instr.(ssa.Value); ok {
@@ -407,14 +488,13 @@ func (visit *visitor) function(fn *ssa.Function) {
 		pfn.Blocks = append(pfn.Blocks, offset)
 		pfn.Instrs = append(pfn.Instrs, Instrs[:index]...)
 		pfn.ssaInstrs = append(pfn.ssaInstrs, ssaInstrs[:index]...)
-		if b == fn.Recover && visit.intp.ctx.Mode&DisableRecover == 0 {
+		if b == fn.Recover && interp.conf.mode&DisableRecover == 0 {
 			pfn.Recover = pfn.Instrs[offset:]
 		}
 	}
 	pfn.makeInstr = nil
-	pfn.base = visit.base
-	visit.base += len(pfn.ssaInstrs) + 2
 	pfn.initPool()
+	atomic.StoreInt32(&pfn.compiled, 1)
 }
 
 func loc(fset *token.FileSet, pos token.Pos) string {
@@ -18,11 +18,14 @@ package igop
 
 import (
 	"fmt"
+	"go/ast"
+	"go/parser"
 	"go/token"
 	"go/types"
 	"log"
 	"reflect"
 	"strconv"
+	"sync"
 	"unsafe"
 
 	"github.com/goplus/reflectx"
@@ -178,6 +181,7 @@ type TypesRecord struct {
 	ncache  *typeutil.Map //nested cache
 	fntargs string        //reflect type arguments used to instantiate the current func
 	nested  map[*types.Named]int
+	npos    map[token.Pos]int // nested index by position of type name
 	nstack  nestedStack
 }
 
@@ -189,9 +193,16 @@ func (r *TypesRecord) Release() {
 	r.ncache = nil
 	r.finder = nil
 	r.nested = nil
+	r.npos = nil
 }
 
 func NewTypesRecord(rctx *reflectx.Context, loader Loader, finder FindMethod, nested map[*types.Named]int) *TypesRecord {
+	npos := make(map[token.Pos]int)
+	for t, n := range nested {
+		if pos := t.Obj().Pos(); pos.IsValid() {
+			npos[pos] = n
+		}
+	}
 	return &TypesRecord{
 		rctx:   rctx,
 		loader: loader,
@@ -199,6 +210,7 @@ func NewTypesRecord(rctx *reflectx.Context, loader Loader, finder FindMethod, ne
 		rcache: make(map[reflect.Type]types.Type),
 		tcache: &typeutil.Map{},
 		nested: nested,
+		npos:   npos,
 	}
 }
 
@@ -226,6 +238,9 @@ func (r *TypesRecord) saveType(typ types.Type, rt reflect.Type, nested bool) {
 }
 
 func (r *TypesRecord) ToType(typ types.Type) (reflect.Type, bool) {
+	if rt, ok := opaqueType(typ); ok {
+		return rt, false
+	}
 	if rt, ok, nested := r.LookupReflect(typ); ok {
 		return rt, nested
 	}
@@ -280,6 +295,10 @@ func (r *TypesRecord) ToType(typ types.Type) (reflect.Type, bool) {
 		_, nested = r.ToTypeList(t)
 		rt = reflect.TypeOf((*_tuple)(nil)).Elem()
 	default:
+		if t, ok := unalias(typ); ok {
+			rt, nested = r.ToType(t)
+			break
+		}
 		panic(fmt.Errorf("ToType: not handled %v", typ))
 	}
 	r.saveType(typ, rt, nested)
@@ -288,6 +307,69 @@ func (r *TypesRecord) ToType(typ types.Type) (reflect.Type, bool) {
 
 type _tuple struct{}
 
+var (
+	deferStackOnce sync.Once
+	deferStackType types.Type
+)
+
+// lookupDeferStackType return the ssa internal type of ssa:deferstack, it
+// is the DeferStack type of Defer instr build without lifting.
+func lookupDeferStackType() types.Type {
+	deferStackOnce.Do(func() {
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, "deferstack.go", "package p\nfunc f() { defer f() }", 0)
+		if err != nil {
+			panic(err)
+		}
+		info := &types.Info{
+			Types:      make(map[ast.Expr]types.TypeAndValue),
+			Defs:       make(map[*ast.Ident]types.Object),
+			Uses:       make(map[*ast.Ident]types.Object),
+			Implicits:  make(map[ast.Node]types.Object),
+			Scopes:     make(map[ast.Node]*types.Scope),
+			Selections: make(map[*ast.SelectorExpr]*types.Selection),
+		}
+		tpkg, err := new(types.Config).Check("p", fset, []*ast.File{f}, info)
+		if err != nil {
+			panic(err)
+		}
+		pkg := ssa.NewProgram(fset, ssa.NaiveForm).CreatePackage(tpkg, []*ast.File{f}, info, false)
+		pkg.Build()
+		for _, b := range pkg.Func("f").Blocks {
+			for _, instr := range b.Instrs {
+				if d, ok := instr.(*ssa.Defer); ok && d.DeferStack != nil {
+					deferStackType = d.DeferStack.Type().Underlying().(*types.Pointer).Elem()
+				}
+			}
+		}
+	})
+	return deferStackType
+}
+
+// opaqueType convert the ssa internal type of ssa:deferstack, the defer
+// stack is *frame. typeutil.Map not support hash opaque type.
+func opaqueType(typ types.Type) (reflect.Type, bool) {
+	switch t := typ.(type) {
+	case *types.Pointer:
+		if elem, ok := opaqueType(t.Elem()); ok {
+			return reflect.PtrTo(elem), true
+		}
+	case *types.Signature:
+		if t.Params().Len() == 0 && t.Results().Len() == 1 {
+			if out, ok := opaqueType(t.Results().At(0).Type()); ok {
+				return reflect.FuncOf(nil, []reflect.Type{out}, false), true
+			}
+		}
+	case *types.Basic, *types.Named, *types.Struct, *types.Interface,
+		*types.Slice, *types.Array, *types.Map, *types.Chan, *types.Tuple:
+	default:
+		if deferStack := lookupDeferStackType(); deferStack != nil && typ == deferStack {
+			return typFramePtr.Elem(), true
+		}
+	}
+	return nil, false
+}
+
 func (r *TypesRecord) toInterfaceType(t *types.Interface) (reflect.Type, bool) {
 	n := t.NumMethods()
 	if n == 0 {